@@ -3,6 +3,7 @@ package ante
 import (
 	blobante "github.com/celestiaorg/celestia-app/v3/x/blob/ante"
 	blob "github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
+	namespace "github.com/celestiaorg/celestia-app/v3/x/namespace/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -16,6 +17,7 @@ func NewAnteHandler(
 	accountKeeper ante.AccountKeeper,
 	bankKeeper authtypes.BankKeeper,
 	blobKeeper blob.Keeper,
+	namespaceKeeper namespace.Keeper,
 	feegrantKeeper ante.FeegrantKeeper,
 	signModeHandler signing.SignModeHandler,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
@@ -73,6 +75,9 @@ func NewAnteHandler(
 		// available to blob data in a data square. Only applies to app version
 		// >= 2.
 		blobante.NewBlobShareDecorator(blobKeeper),
+		// Ensure that the signer of a PFB is allowed to write to the
+		// registered namespaces of its blobs. Only applies to app version >= 4.
+		blobante.NewNamespaceWritersDecorator(namespaceKeeper),
 		// Ensure that tx's with a MsgSubmitProposal have at least one proposal
 		// message.
 		NewGovProposalDecorator(),
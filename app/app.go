@@ -10,6 +10,8 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/app/ante"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	celestiablob "github.com/celestiaorg/celestia-app/v3/app/grpc/blob"
+	celestiadas "github.com/celestiaorg/celestia-app/v3/app/grpc/das"
 	celestiatx "github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v3/app/module"
 	"github.com/celestiaorg/celestia-app/v3/app/posthandler"
@@ -17,6 +19,8 @@ import (
 	appv1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	appv2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	appv3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	appv4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
 	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
 	blobkeeper "github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
@@ -25,6 +29,8 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 	mintkeeper "github.com/celestiaorg/celestia-app/v3/x/mint/keeper"
 	minttypes "github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	namespacekeeper "github.com/celestiaorg/celestia-app/v3/x/namespace/keeper"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
 	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
 	"github.com/celestiaorg/celestia-app/v3/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
@@ -114,7 +120,13 @@ const (
 	v1                    = appv1.Version
 	v2                    = appv2.Version
 	v3                    = appv3.Version
+	v4                    = appv4.Version
 	DefaultInitialVersion = v1
+
+	// FlagEDSWorkers is the flag to specify the number of workers used to
+	// erasure code the data square and compute its roots. Defaults to
+	// GOMAXPROCS.
+	FlagEDSWorkers = "eds-workers"
 )
 
 var (
@@ -163,6 +175,7 @@ type App struct {
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 	BlobKeeper          blobkeeper.Keeper
 	BlobstreamKeeper    blobstreamkeeper.Keeper
+	NamespaceKeeper     namespacekeeper.Keeper
 
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
@@ -180,6 +193,16 @@ type App struct {
 	// MsgGateKeeper is used to define which messages are accepted for a given
 	// app version.
 	MsgGateKeeper *ante.MsgVersioningGateKeeper
+	// edsCache holds the extended data squares of recent heights so that they
+	// are computed once for PrepareProposal, ProcessProposal, the proof
+	// queries and the DAS service.
+	edsCache *da.Cache
+	// extender erasure codes the data squares of the proposals using a
+	// bounded pool of workers.
+	extender *da.Extender
+	// txPrices holds the gas prices of the transactions accepted by CheckTx
+	// so that pending transactions can be replaced by higher priced ones.
+	txPrices *txPrices
 }
 
 // New returns a reference to an uninitialized app. Callers must subsequently
@@ -223,6 +246,9 @@ func New(
 		memKeys:           memKeys,
 		upgradeHeightV2:   upgradeHeightV2,
 		timeoutCommit:     timeoutCommit,
+		edsCache:          da.NewCache(da.DefaultCacheSize),
+		extender:          da.NewExtender(cast.ToInt(appOpts.Get(FlagEDSWorkers))),
+		txPrices:          newTxPrices(),
 	}
 
 	app.ParamsKeeper = initParamsKeeper(appCodec, encodingConfig.Amino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
@@ -250,6 +276,7 @@ func New(
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec,
 		keys[minttypes.StoreKey],
+		app.GetSubspace(minttypes.ModuleName),
 		&stakingKeeper,
 		app.AccountKeeper,
 		app.BankKeeper,
@@ -289,7 +316,8 @@ func New(
 		),
 	)
 
-	app.SignalKeeper = signal.NewKeeper(appCodec, keys[signaltypes.StoreKey], app.StakingKeeper)
+	app.SignalKeeper = signal.NewKeeper(appCodec, keys[signaltypes.StoreKey], app.GetSubspace(signaltypes.ModuleName), app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.SignalKeeper.SetSupportedVersions(app.SupportedVersions)
 
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
@@ -316,7 +344,7 @@ func New(
 
 	// Register the proposal types.
 	govRouter := oldgovtypes.NewRouter()
-	govRouter.AddRoute(paramproposal.RouterKey, paramBlockList.GovHandler(app.ParamsKeeper)).
+	govRouter.AddRoute(paramproposal.RouterKey, versionedParamsGovHandler(app.validatedParamsGovHandler(paramBlockList.GovHandler(app.ParamsKeeper)))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
 
@@ -353,10 +381,10 @@ func New(
 		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,  // refund timeout
 	)
 	// PacketForwardMiddleware is used only for version >= 2.
-	transferStack = module.NewVersionedIBCModule(packetForwardMiddleware, transferStack, v2, v3)
+	transferStack = module.NewVersionedIBCModule(packetForwardMiddleware, transferStack, v2, v4)
 	// Token filter wraps packet forward middleware and is thus the first module in the transfer stack.
 	tokenFilterMiddleware := tokenfilter.NewIBCMiddleware(transferStack)
-	transferStack = module.NewVersionedIBCModule(tokenFilterMiddleware, transferStack, v1, v3)
+	transferStack = module.NewVersionedIBCModule(tokenFilterMiddleware, transferStack, v1, v4)
 
 	app.EvidenceKeeper = *evidencekeeper.NewKeeper(
 		appCodec,
@@ -382,6 +410,14 @@ func New(
 		app.GetSubspace(blobtypes.ModuleName),
 	)
 
+	app.NamespaceKeeper = namespacekeeper.NewKeeper(
+		appCodec,
+		keys[namespacetypes.StoreKey],
+		app.GetSubspace(namespacetypes.ModuleName),
+		app.BankKeeper,
+	)
+	app.BlobKeeper.SetNamespaceKeeper(app.NamespaceKeeper)
+
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 	ibcRouter := ibcporttypes.NewRouter()                                                   // Create static IBC router
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)                          // Add transfer route
@@ -403,8 +439,10 @@ func New(
 	// order begin block, end block and init genesis
 	app.setModuleOrder()
 
-	app.QueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
-	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	app.QueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.NewTxInclusionProofQuerier(app.edsCache))
+	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.NewShareInclusionProofQuerier(app.edsCache))
+	app.QueryRouter().AddRoute(proof.NamespaceProofQueryPath, proof.NewNamespaceProofQuerier(app.edsCache))
+	app.QueryRouter().AddRoute(proof.BlobProofQueryPath, proof.QueryBlobProof)
 
 	app.manager.RegisterInvariants(&app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -428,6 +466,7 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.BlobKeeper,
+		app.NamespaceKeeper,
 		app.FeeGrantKeeper,
 		encodingConfig.TxConfig.SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -489,6 +528,8 @@ func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 			app.SetAppVersion(ctx, newVersion)
 			app.SignalKeeper.ResetTally(ctx)
 		}
+	} else {
+		res.Events = append(res.Events, app.upgradeReadinessEvents(ctx, currentVersion)...)
 	}
 	res.Timeouts.TimeoutCommit = app.getTimeoutCommit(currentVersion)
 	res.Timeouts.TimeoutPropose = appconsts.GetTimeoutPropose(currentVersion)
@@ -687,6 +728,11 @@ func (app *App) GetTxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// EDSCacheStats returns the statistics of the cache of extended data squares.
+func (app *App) EDSCacheStats() da.CacheStats {
+	return app.edsCache.Stats()
+}
+
 // LegacyAmino returns SimApp's amino codec.
 //
 // NOTE: This is solely to be used for testing purposes as it may be desirable
@@ -749,13 +795,17 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	celestiatx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	celestiablob.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	celestiadas.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
-	celestiatx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
-	gasestimation.RegisterGasEstimationService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate)
+	celestiatx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry, app.SquareLayout)
+	celestiablob.RegisterBlobService(app.BaseApp.GRPCQueryRouter(), clientCtx)
+	celestiadas.RegisterDASService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.edsCache)
+	gasestimation.RegisterGasEstimationServiceWithState(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.MaxEffectiveSquareSize, minfee.NewQueryServerImpl(app.ParamsKeeper))
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -782,6 +832,63 @@ func (app *App) BlockedParams() [][2]string {
 	}
 }
 
+// paramsAddedIn maps the subspaces whose params were added after v1 to the app
+// version that added them.
+var paramsAddedIn = map[string]uint64{
+	minttypes.ModuleName:      v4,
+	namespacetypes.ModuleName: v4,
+	signaltypes.ModuleName:    v4,
+}
+
+// versionedParamsGovHandler wraps the handler of param change proposals to
+// reject the changes of params that were added in a later app version than the
+// current one. The binaries of the previous versions don't know these params so
+// applying the changes would make them diverge.
+func versionedParamsGovHandler(handler oldgovtypes.Handler) oldgovtypes.Handler {
+	return func(ctx sdk.Context, content oldgovtypes.Content) error {
+		if p, ok := content.(*paramproposal.ParameterChangeProposal); ok {
+			for _, c := range p.Changes {
+				if version, ok := paramsAddedIn[c.Subspace]; ok && ctx.BlockHeader().Version.App < version {
+					return paramfilter.ErrBlockedParameter.Wrapf("%s params can't be changed before app version %d", c.Subspace, version)
+				}
+			}
+		}
+		return handler(ctx, content)
+	}
+}
+
+// validatedParamsGovHandler wraps the handler of param change proposals to
+// validate the params of the subspaces whose params depend on each other once
+// all the changes of a proposal are applied. The subspace only validates each
+// param on its own as a proposal changes them one at a time. The changes are
+// discarded if the resulting params are invalid.
+func (app *App) validatedParamsGovHandler(handler oldgovtypes.Handler) oldgovtypes.Handler {
+	validators := map[string]func(ctx sdk.Context) error{
+		minttypes.ModuleName: func(ctx sdk.Context) error {
+			return app.MintKeeper.GetParams(ctx).Validate()
+		},
+	}
+	return func(ctx sdk.Context, content oldgovtypes.Content) error {
+		p, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return handler(ctx, content)
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := handler(cacheCtx, content); err != nil {
+			return err
+		}
+		for _, c := range p.Changes {
+			if validate, ok := validators[c.Subspace]; ok {
+				if err := validate(cacheCtx); err != nil {
+					return paramproposal.ErrSettingParameter.Wrapf("invalid %s params: %v", c.Subspace, err)
+				}
+			}
+		}
+		writeCache()
+		return nil
+	}
+}
+
 // initParamsKeeper initializes the params keeper and its subspaces.
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
@@ -801,6 +908,8 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(blobstreamtypes.ModuleName)
 	paramsKeeper.Subspace(minfee.ModuleName)
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName)
+	paramsKeeper.Subspace(namespacetypes.ModuleName)
+	paramsKeeper.Subspace(signaltypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -857,7 +966,7 @@ func (app *App) OfferSnapshot(req abci.RequestOfferSnapshot) abci.ResponseOfferS
 }
 
 func isSupportedAppVersion(appVersion uint64) bool {
-	return appVersion == v1 || appVersion == v2 || appVersion == v3
+	return appVersion == v1 || appVersion == v2 || appVersion == v3 || appVersion == v4
 }
 
 // getTimeoutCommit returns the timeoutCommit if a user has overridden it via the
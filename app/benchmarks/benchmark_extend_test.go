@@ -0,0 +1,68 @@
+//go:build bench_abci_methods
+
+package benchmarks_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/require"
+)
+
+var extendSquareSizes = []int{64, 128, 256, 512}
+
+// BenchmarkExtendShares measures the time to erasure code a square and compute
+// its data availability header with da.ExtendShares.
+func BenchmarkExtendShares(b *testing.B) {
+	for _, squareSize := range extendSquareSizes {
+		shares := randomSquareShares(b, squareSize)
+		b.Run(fmt.Sprintf("square size %d", squareSize), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				eds, err := da.ExtendShares(shares)
+				require.NoError(b, err)
+				_, err = da.NewDataAvailabilityHeader(eds)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+// BenchmarkExtender measures the time to erasure code a square and compute its
+// data availability header with a da.Extender of different number of
+// workers.
+func BenchmarkExtender(b *testing.B) {
+	for _, squareSize := range extendSquareSizes {
+		shares := randomSquareShares(b, squareSize)
+		for _, workers := range []int{1, 4, 16} {
+			b.Run(fmt.Sprintf("square size %d with %d workers", squareSize, workers), func(b *testing.B) {
+				extender := da.NewExtender(workers)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					eds, err := extender.ExtendShares(shares)
+					require.NoError(b, err)
+					_, err = da.NewDataAvailabilityHeader(eds)
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
+
+// randomSquareShares returns the shares of a square of squareSize filled with
+// random data and namespaces in ascending order.
+func randomSquareShares(b *testing.B, squareSize int) [][]byte {
+	shares := make([][]byte, squareSize*squareSize)
+	for i := range shares {
+		id := make([]byte, share.NamespaceVersionZeroIDSize)
+		id[len(id)-3], id[len(id)-2], id[len(id)-1] = byte(i>>16), byte(i>>8), byte(i)
+		data := make([]byte, share.ShareSize-share.NamespaceSize)
+		_, err := rand.Read(data)
+		require.NoError(b, err)
+		shares[i] = append(share.MustNewV0Namespace(id).Bytes(), data...)
+	}
+	return shares
+}
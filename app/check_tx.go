@@ -2,12 +2,14 @@ package app
 
 import (
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/errors"
 
 	apperr "github.com/celestiaorg/celestia-app/v3/app/errors"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	blobtx "github.com/celestiaorg/go-square/v2/tx"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -38,14 +40,24 @@ func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		if err != nil {
 			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false)
 		}
-		// reject transactions that have a MsgPFB but no blobs attached to the tx
 		for _, msg := range sdkTx.GetMsgs() {
-			if _, ok := msg.(*blobtypes.MsgPayForBlobs); !ok {
-				continue
+			switch msg := msg.(type) {
+			// reject transactions that have a MsgPFB but no blobs attached to the tx
+			case *blobtypes.MsgPayForBlobs:
+				return sdkerrors.ResponseCheckTxWithEvents(blobtypes.ErrNoBlobs, 0, 0, []abci.Event{}, false)
+			// refuse signals for versions that this binary can't run, as the
+			// node wouldn't be able to follow the chain after the upgrade
+			case *signaltypes.MsgSignalVersion:
+				if !slices.Contains(app.SupportedVersions(), msg.Version) {
+					err := signaltypes.ErrUnsupportedVersion.Wrapf("app version %d, supported versions are %v", msg.Version, app.SupportedVersions())
+					return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false)
+				}
 			}
-			return sdkerrors.ResponseCheckTxWithEvents(blobtypes.ErrNoBlobs, 0, 0, []abci.Event{}, false)
 		}
 		// don't do anything special if we have a normal transaction
+		if req.Type == abci.CheckTxType_New {
+			return app.checkNewTx(req)
+		}
 		return app.BaseApp.CheckTx(req)
 	}
 
@@ -57,6 +69,8 @@ func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		if err != nil {
 			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false)
 		}
+		req.Tx = btx.Tx
+		return app.checkNewTx(req)
 	case abci.CheckTxType_Recheck:
 	default:
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
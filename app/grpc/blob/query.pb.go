@@ -0,0 +1,1164 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/blob/query.proto
+
+package blob
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlobsByNamespaceRequest is the request type for the BlobsByNamespace gRPC
+// method.
+type BlobsByNamespaceRequest struct {
+	// height is the height of the block. The latest block is used if it is zero.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the namespace of the blobs.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *BlobsByNamespaceRequest) Reset()         { *m = BlobsByNamespaceRequest{} }
+func (m *BlobsByNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*BlobsByNamespaceRequest) ProtoMessage()    {}
+func (*BlobsByNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9973aee34d06e363, []int{0}
+}
+func (m *BlobsByNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobsByNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobsByNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobsByNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobsByNamespaceRequest.Merge(m, src)
+}
+func (m *BlobsByNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobsByNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobsByNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobsByNamespaceRequest proto.InternalMessageInfo
+
+func (m *BlobsByNamespaceRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlobsByNamespaceRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// BlobsByNamespaceResponse is the response type for the BlobsByNamespace gRPC
+// method.
+type BlobsByNamespaceResponse struct {
+	Blobs []*CommittedBlob `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	// height is the height of the block the blobs were committed at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// square_size is the size of the square of the block.
+	SquareSize uint64 `protobuf:"varint,3,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+}
+
+func (m *BlobsByNamespaceResponse) Reset()         { *m = BlobsByNamespaceResponse{} }
+func (m *BlobsByNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*BlobsByNamespaceResponse) ProtoMessage()    {}
+func (*BlobsByNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9973aee34d06e363, []int{1}
+}
+func (m *BlobsByNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobsByNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobsByNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobsByNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobsByNamespaceResponse.Merge(m, src)
+}
+func (m *BlobsByNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobsByNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobsByNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobsByNamespaceResponse proto.InternalMessageInfo
+
+func (m *BlobsByNamespaceResponse) GetBlobs() []*CommittedBlob {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *BlobsByNamespaceResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlobsByNamespaceResponse) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+// CommittedBlob is a blob committed in a block.
+type CommittedBlob struct {
+	Namespace    []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Data         []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ShareVersion uint32 `protobuf:"varint,3,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
+	// signer is the address of the signer of the blob. It is only set for share
+	// version 1.
+	Signer []byte `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// share_commitment is the commitment of the blob the PayForBlobs message
+	// paid for.
+	ShareCommitment []byte `protobuf:"bytes,5,opt,name=share_commitment,json=shareCommitment,proto3" json:"share_commitment,omitempty"`
+	// start_index is the index of the first share of the blob in the square.
+	StartIndex uint32 `protobuf:"varint,6,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	// tx_index is the index of the BlobTx of the blob in the block.
+	TxIndex uint32 `protobuf:"varint,7,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+}
+
+func (m *CommittedBlob) Reset()         { *m = CommittedBlob{} }
+func (m *CommittedBlob) String() string { return proto.CompactTextString(m) }
+func (*CommittedBlob) ProtoMessage()    {}
+func (*CommittedBlob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9973aee34d06e363, []int{2}
+}
+func (m *CommittedBlob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommittedBlob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommittedBlob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommittedBlob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommittedBlob.Merge(m, src)
+}
+func (m *CommittedBlob) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommittedBlob) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommittedBlob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommittedBlob proto.InternalMessageInfo
+
+func (m *CommittedBlob) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *CommittedBlob) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *CommittedBlob) GetShareVersion() uint32 {
+	if m != nil {
+		return m.ShareVersion
+	}
+	return 0
+}
+
+func (m *CommittedBlob) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func (m *CommittedBlob) GetShareCommitment() []byte {
+	if m != nil {
+		return m.ShareCommitment
+	}
+	return nil
+}
+
+func (m *CommittedBlob) GetStartIndex() uint32 {
+	if m != nil {
+		return m.StartIndex
+	}
+	return 0
+}
+
+func (m *CommittedBlob) GetTxIndex() uint32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BlobsByNamespaceRequest)(nil), "celestia.core.v1.blob.BlobsByNamespaceRequest")
+	proto.RegisterType((*BlobsByNamespaceResponse)(nil), "celestia.core.v1.blob.BlobsByNamespaceResponse")
+	proto.RegisterType((*CommittedBlob)(nil), "celestia.core.v1.blob.CommittedBlob")
+}
+
+func init() { proto.RegisterFile("celestia/core/v1/blob/query.proto", fileDescriptor_9973aee34d06e363) }
+
+var fileDescriptor_9973aee34d06e363 = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x24, 0x69, 0xaa, 0xaf, 0x0d, 0x96, 0x01, 0x75, 0x2d, 0x65, 0x9b, 0x46, 0xc1, 0x78,
+	0x70, 0x87, 0xd4, 0x9b, 0xc7, 0xf4, 0x24, 0x82, 0xc2, 0x0a, 0x1e, 0xbc, 0x94, 0xd9, 0xcd, 0x63,
+	0x33, 0x90, 0x9d, 0xd9, 0xcc, 0x4c, 0x42, 0x5a, 0xf1, 0xe2, 0x1f, 0x50, 0xf0, 0xea, 0xd5, 0xff,
+	0xe2, 0xb1, 0xe0, 0xc5, 0xa3, 0x24, 0xfa, 0x3f, 0x64, 0x66, 0xb7, 0xd1, 0xd4, 0x06, 0x3c, 0x2c,
+	0xcc, 0x7c, 0xef, 0x7b, 0xdf, 0xfb, 0xbe, 0x7d, 0x03, 0x47, 0x29, 0x8e, 0xd1, 0x58, 0xc1, 0x59,
+	0xaa, 0x34, 0xb2, 0x59, 0x9f, 0x25, 0x63, 0x95, 0xb0, 0xc9, 0x14, 0xf5, 0x59, 0x54, 0x68, 0x65,
+	0x15, 0xbd, 0x7d, 0x49, 0x89, 0x1c, 0x25, 0x9a, 0xf5, 0x23, 0x47, 0xd9, 0x3f, 0xc8, 0x94, 0xca,
+	0xc6, 0xc8, 0x78, 0x21, 0x18, 0x97, 0x52, 0x59, 0x6e, 0x85, 0x92, 0xa6, 0x6c, 0xea, 0xbe, 0x84,
+	0xbb, 0x83, 0xb1, 0x4a, 0xcc, 0xe0, 0xec, 0x05, 0xcf, 0xd1, 0x14, 0x3c, 0xc5, 0x18, 0x27, 0x53,
+	0x34, 0x96, 0xde, 0x81, 0xd6, 0x08, 0x45, 0x36, 0xb2, 0x01, 0xe9, 0x90, 0x5e, 0x23, 0xae, 0x6e,
+	0xf4, 0x00, 0x6e, 0xca, 0x4b, 0x6e, 0x50, 0xef, 0x90, 0xde, 0x6e, 0xfc, 0x07, 0xe8, 0x7e, 0x20,
+	0x10, 0xfc, 0xab, 0x68, 0x0a, 0x25, 0x0d, 0xd2, 0xa7, 0xb0, 0xe5, 0x3c, 0x99, 0x80, 0x74, 0x1a,
+	0xbd, 0x9d, 0xe3, 0x07, 0xd1, 0xb5, 0x96, 0xa3, 0x13, 0x95, 0xe7, 0xc2, 0x5a, 0x1c, 0x3a, 0xa1,
+	0xb8, 0x6c, 0xf9, 0xcb, 0x4e, 0x7d, 0xcd, 0xce, 0x21, 0xec, 0x98, 0xc9, 0x94, 0x6b, 0x3c, 0x35,
+	0xe2, 0x1c, 0x83, 0x46, 0x87, 0xf4, 0x9a, 0x31, 0x94, 0xd0, 0x2b, 0x71, 0x8e, 0xdd, 0x5f, 0x04,
+	0xda, 0x6b, 0x8a, 0xeb, 0x09, 0xc8, 0x95, 0x04, 0x94, 0x42, 0x73, 0xc8, 0x2d, 0xaf, 0xa2, 0xf9,
+	0x33, 0xbd, 0x0f, 0x6d, 0x33, 0x72, 0x33, 0x66, 0xa8, 0x8d, 0x50, 0xd2, 0x8f, 0x69, 0xc7, 0xbb,
+	0x1e, 0x7c, 0x5d, 0x62, 0xce, 0xa1, 0x11, 0x99, 0x44, 0x1d, 0x34, 0x7d, 0x6b, 0x75, 0xa3, 0x8f,
+	0x60, 0xaf, 0x6c, 0x4e, 0xbd, 0x8b, 0x1c, 0xa5, 0x0d, 0xb6, 0x3c, 0xe3, 0x96, 0xc7, 0x4f, 0x56,
+	0xb0, 0x0f, 0x63, 0xb9, 0xb6, 0xa7, 0x42, 0x0e, 0x71, 0x1e, 0xb4, 0xfc, 0x14, 0xf0, 0xd0, 0x33,
+	0x87, 0xd0, 0x7b, 0x70, 0xc3, 0xce, 0xab, 0xea, 0xb6, 0xaf, 0x6e, 0xdb, 0xb9, 0x2f, 0x1d, 0x7f,
+	0x21, 0xd0, 0xf4, 0xf1, 0x3e, 0x13, 0xd8, 0xbb, 0xba, 0x02, 0x1a, 0x6d, 0xf8, 0xd7, 0x1b, 0xb6,
+	0xbf, 0xcf, 0xfe, 0x9b, 0x5f, 0xee, 0xb6, 0xfb, 0xf0, 0xfd, 0xb7, 0x9f, 0x9f, 0xea, 0x47, 0xf4,
+	0x90, 0x5d, 0xff, 0x54, 0xdf, 0x96, 0xfb, 0x7a, 0x37, 0x78, 0xfe, 0x75, 0x11, 0x92, 0x8b, 0x45,
+	0x48, 0x7e, 0x2c, 0x42, 0xf2, 0x71, 0x19, 0xd6, 0x2e, 0x96, 0x61, 0xed, 0xfb, 0x32, 0xac, 0xbd,
+	0xe9, 0x67, 0xc2, 0x8e, 0xa6, 0x49, 0x94, 0xaa, 0x7c, 0x25, 0xa2, 0x74, 0xb6, 0x3a, 0x3f, 0xe6,
+	0x45, 0xc1, 0xdc, 0x97, 0xe9, 0x22, 0xf5, 0xaa, 0x49, 0xcb, 0x3f, 0xe3, 0x27, 0xbf, 0x07, 0x00,
+	0x4d, 0x68, 0xe3, 0xeb, 0x20, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlobClient is the client API for Blob service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlobClient interface {
+	// BlobsByNamespace returns the blobs of a namespace committed at a height.
+	// The blobs are extracted from the square reconstructed from the block data
+	// and are returned in the order they appear in the square.
+	BlobsByNamespace(ctx context.Context, in *BlobsByNamespaceRequest, opts ...grpc.CallOption) (*BlobsByNamespaceResponse, error)
+}
+
+type blobClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlobClient(cc grpc1.ClientConn) BlobClient {
+	return &blobClient{cc}
+}
+
+func (c *blobClient) BlobsByNamespace(ctx context.Context, in *BlobsByNamespaceRequest, opts ...grpc.CallOption) (*BlobsByNamespaceResponse, error) {
+	out := new(BlobsByNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.blob.Blob/BlobsByNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlobServer is the server API for Blob service.
+type BlobServer interface {
+	// BlobsByNamespace returns the blobs of a namespace committed at a height.
+	// The blobs are extracted from the square reconstructed from the block data
+	// and are returned in the order they appear in the square.
+	BlobsByNamespace(context.Context, *BlobsByNamespaceRequest) (*BlobsByNamespaceResponse, error)
+}
+
+// UnimplementedBlobServer can be embedded to have forward compatible implementations.
+type UnimplementedBlobServer struct {
+}
+
+func (*UnimplementedBlobServer) BlobsByNamespace(ctx context.Context, req *BlobsByNamespaceRequest) (*BlobsByNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobsByNamespace not implemented")
+}
+
+func RegisterBlobServer(s grpc1.Server, srv BlobServer) {
+	s.RegisterService(&_Blob_serviceDesc, srv)
+}
+
+func _Blob_BlobsByNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobsByNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServer).BlobsByNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.blob.Blob/BlobsByNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServer).BlobsByNamespace(ctx, req.(*BlobsByNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Blob_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.blob.Blob",
+	HandlerType: (*BlobServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobsByNamespace",
+			Handler:    _Blob_BlobsByNamespace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/blob/query.proto",
+}
+
+func (m *BlobsByNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobsByNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobsByNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobsByNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobsByNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobsByNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SquareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommittedBlob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommittedBlob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommittedBlob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ShareCommitment) > 0 {
+		i -= len(m.ShareCommitment)
+		copy(dAtA[i:], m.ShareCommitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ShareCommitment)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ShareVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShareVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlobsByNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobsByNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.SquareSize != 0 {
+		n += 1 + sovQuery(uint64(m.SquareSize))
+	}
+	return n
+}
+
+func (m *CommittedBlob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ShareVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ShareVersion))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ShareCommitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartIndex != 0 {
+		n += 1 + sovQuery(uint64(m.StartIndex))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlobsByNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobsByNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobsByNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobsByNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobsByNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobsByNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &CommittedBlob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommittedBlob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommittedBlob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommittedBlob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersion", wireType)
+			}
+			m.ShareVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitment = append(m.ShareCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareCommitment == nil {
+				m.ShareCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
+			}
+			m.StartIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
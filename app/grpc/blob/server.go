@@ -0,0 +1,167 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	coretypes "github.com/tendermint/tendermint/types"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// RegisterBlobService registers the blob service on the gRPC router.
+func RegisterBlobService(qrt gogogrpc.Server, clientCtx client.Context) {
+	RegisterBlobServer(
+		qrt,
+		NewBlobServer(clientCtx),
+	)
+}
+
+// RegisterGRPCGatewayRoutes mounts the blob service's GRPC-gateway routes on
+// the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterBlobHandlerClient(context.Background(), mux, NewBlobClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ BlobServer = &blobServer{}
+
+type blobServer struct {
+	clientCtx client.Context
+}
+
+func NewBlobServer(clientCtx client.Context) BlobServer {
+	return &blobServer{
+		clientCtx: clientCtx,
+	}
+}
+
+// BlobsByNamespace implements the BlobServer.BlobsByNamespace method. It
+// fetches the block from the underlying celestia-core RPC server and
+// reconstructs its square to extract the blobs of the namespace.
+func (s *blobServer) BlobsByNamespace(ctx context.Context, req *BlobsByNamespaceRequest) (*BlobsByNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d cannot be negative", req.Height)
+	}
+	namespace, err := share.NewNamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+	if err := blobtypes.ValidateBlobNamespace(namespace); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	var height *int64
+	if req.Height != 0 {
+		height = &req.Height
+	}
+	resBlock, err := node.Block(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs, squareSize, err := blobsFromBlock(resBlock.Block, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &BlobsByNamespaceResponse{
+		Blobs:      blobs,
+		Height:     resBlock.Block.Height,
+		SquareSize: squareSize,
+	}, nil
+}
+
+// blobsFromBlock reconstructs the square of the block and returns the blobs of
+// the namespace in the order they appear in the square, along with the size of
+// the square. As the application's state isn't available, the upper bound
+// square size is used instead of the square size dictated by governance.
+func blobsFromBlock(block *coretypes.Block, namespace share.Namespace) ([]*CommittedBlob, uint64, error) {
+	appVersion := block.Header.Version.App
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(appVersion)
+	txs := block.Data.Txs.ToSliceOfBytes()
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), subtreeRootThreshold, txs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	blobs := make([]*CommittedBlob, 0)
+	for txIndex, rawTx := range txs {
+		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlob {
+			continue
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshalling blob tx at index %d: %w", txIndex, err)
+		}
+		for blobIndex, blob := range bTx.Blobs {
+			if !bytes.Equal(blob.Namespace().Bytes(), namespace.Bytes()) {
+				continue
+			}
+			committedBlob, err := extractBlob(builder, dataSquare, txIndex, blobIndex, subtreeRootThreshold)
+			if err != nil {
+				return nil, 0, err
+			}
+			blobs = append(blobs, committedBlob)
+		}
+	}
+	// blobs of the same namespace are laid out in the order of their
+	// transactions, so they are already in square order.
+	return blobs, uint64(dataSquare.Size()), nil
+}
+
+// extractBlob parses a blob from the shares it occupies in the square.
+func extractBlob(builder *square.Builder, dataSquare square.Square, txIndex, blobIndex, subtreeRootThreshold int) (*CommittedBlob, error) {
+	start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+	if err != nil {
+		return nil, err
+	}
+	length, err := builder.BlobShareLength(txIndex, blobIndex)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := share.ParseBlobs(dataSquare[start : start+length])
+	if err != nil {
+		return nil, fmt.Errorf("parsing blob %d of tx %d: %w", blobIndex, txIndex, err)
+	}
+	if len(parsed) != 1 {
+		return nil, fmt.Errorf("expected 1 blob in the shares of blob %d of tx %d, got %d", blobIndex, txIndex, len(parsed))
+	}
+	blob := parsed[0]
+	commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, subtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return &CommittedBlob{
+		Namespace:       blob.Namespace().Bytes(),
+		Data:            blob.Data(),
+		ShareVersion:    uint32(blob.ShareVersion()),
+		Signer:          blob.Signer(),
+		ShareCommitment: commitment,
+		StartIndex:      uint32(start),
+		TxIndex:         uint32(txIndex),
+	}, nil
+}
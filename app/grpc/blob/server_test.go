@@ -0,0 +1,94 @@
+package blob
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestBlobsFromBlock(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	ns3 := share.MustNewV0Namespace(bytes.Repeat([]byte{3}, share.NamespaceVersionZeroIDSize))
+
+	blobs := []*share.Blob{
+		newBlob(t, ns2, 100),
+		newBlob(t, ns1, 1000),
+		newBlob(t, ns2, 2000),
+	}
+	// the square builder doesn't validate the sdk transactions
+	firstBlobTx, err := blobtx.MarshalBlobTx([]byte("first"), blobs[0], blobs[1])
+	require.NoError(t, err)
+	secondBlobTx, err := blobtx.MarshalBlobTx([]byte("second"), blobs[2])
+	require.NoError(t, err)
+	txs := coretypes.Txs{[]byte("normal tx"), firstBlobTx, secondBlobTx}
+	block := &coretypes.Block{
+		Header: coretypes.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}},
+		Data:   coretypes.Data{Txs: txs},
+	}
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		namespace share.Namespace
+		// want are the blob, tx and blob within tx indexes of the expected blobs
+		want [][3]int
+	}{
+		{
+			name:      "blobs of multiple transactions",
+			namespace: ns2,
+			want:      [][3]int{{0, 1, 0}, {2, 2, 0}},
+		},
+		{
+			name:      "single blob",
+			namespace: ns1,
+			want:      [][3]int{{1, 1, 1}},
+		},
+		{
+			name:      "no blob in the namespace",
+			namespace: ns3,
+			want:      [][3]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, squareSize, err := blobsFromBlock(block, tt.namespace)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(dataSquare.Size()), squareSize)
+			require.Len(t, got, len(tt.want))
+			for i, want := range tt.want {
+				blob := blobs[want[0]]
+				commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.DefaultSubtreeRootThreshold)
+				require.NoError(t, err)
+				blobRange, err := square.BlobShareRange(txs.ToSliceOfBytes(), want[1], want[2], appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.namespace.Bytes(), got[i].Namespace)
+				assert.Equal(t, blob.Data(), got[i].Data)
+				assert.Equal(t, commitment, got[i].ShareCommitment)
+				assert.Equal(t, uint32(blobRange.Start), got[i].StartIndex)
+				assert.Equal(t, uint32(want[1]), got[i].TxIndex)
+			}
+		})
+	}
+}
+
+func newBlob(t *testing.T, ns share.Namespace, size int) *share.Blob {
+	blob, err := share.NewBlob(ns, tmrand.Bytes(size), share.ShareVersionZero, nil)
+	require.NoError(t, err)
+	return blob
+}
@@ -0,0 +1,814 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/das/query.proto
+
+package das
+
+import (
+	context "context"
+	fmt "fmt"
+	proof "github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Axis is the axis of the extended data square the proof of a share is
+// against.
+type Axis int32
+
+const (
+	// AXIS_ROW proves the share to the root of its row.
+	Axis_AXIS_ROW Axis = 0
+	// AXIS_COL proves the share to the root of its column.
+	Axis_AXIS_COL Axis = 1
+)
+
+var Axis_name = map[int32]string{
+	0: "AXIS_ROW",
+	1: "AXIS_COL",
+}
+
+var Axis_value = map[string]int32{
+	"AXIS_ROW": 0,
+	"AXIS_COL": 1,
+}
+
+func (x Axis) String() string {
+	return proto.EnumName(Axis_name, int32(x))
+}
+
+func (Axis) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5ad9052af994c8f, []int{0}
+}
+
+// SampleShareRequest is the request type for the SampleShare gRPC method.
+type SampleShareRequest struct {
+	// height is the height of the block. The latest block is used if it is zero.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// row is the row of the share in the extended data square.
+	Row uint32 `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	// col is the column of the share in the extended data square.
+	Col  uint32 `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+	Axis Axis   `protobuf:"varint,4,opt,name=axis,proto3,enum=celestia.core.v1.das.Axis" json:"axis,omitempty"`
+}
+
+func (m *SampleShareRequest) Reset()         { *m = SampleShareRequest{} }
+func (m *SampleShareRequest) String() string { return proto.CompactTextString(m) }
+func (*SampleShareRequest) ProtoMessage()    {}
+func (*SampleShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5ad9052af994c8f, []int{0}
+}
+func (m *SampleShareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SampleShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SampleShareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SampleShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SampleShareRequest.Merge(m, src)
+}
+func (m *SampleShareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SampleShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SampleShareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SampleShareRequest proto.InternalMessageInfo
+
+func (m *SampleShareRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SampleShareRequest) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *SampleShareRequest) GetCol() uint32 {
+	if m != nil {
+		return m.Col
+	}
+	return 0
+}
+
+func (m *SampleShareRequest) GetAxis() Axis {
+	if m != nil {
+		return m.Axis
+	}
+	return Axis_AXIS_ROW
+}
+
+// SampleShareResponse is the response type for the SampleShare gRPC method.
+type SampleShareResponse struct {
+	Share []byte `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	// proof is the NMT proof of the share to the root of the requested axis.
+	Proof *proof.NMTProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height is the height of the block the share was sampled from.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SampleShareResponse) Reset()         { *m = SampleShareResponse{} }
+func (m *SampleShareResponse) String() string { return proto.CompactTextString(m) }
+func (*SampleShareResponse) ProtoMessage()    {}
+func (*SampleShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5ad9052af994c8f, []int{1}
+}
+func (m *SampleShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SampleShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SampleShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SampleShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SampleShareResponse.Merge(m, src)
+}
+func (m *SampleShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SampleShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SampleShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SampleShareResponse proto.InternalMessageInfo
+
+func (m *SampleShareResponse) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *SampleShareResponse) GetProof() *proof.NMTProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *SampleShareResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("celestia.core.v1.das.Axis", Axis_name, Axis_value)
+	proto.RegisterType((*SampleShareRequest)(nil), "celestia.core.v1.das.SampleShareRequest")
+	proto.RegisterType((*SampleShareResponse)(nil), "celestia.core.v1.das.SampleShareResponse")
+}
+
+func init() { proto.RegisterFile("celestia/core/v1/das/query.proto", fileDescriptor_f5ad9052af994c8f) }
+
+var fileDescriptor_f5ad9052af994c8f = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x8b, 0xd4, 0x30,
+	0x14, 0xc7, 0x9b, 0xed, 0xec, 0x22, 0xd9, 0x55, 0x86, 0xb8, 0x48, 0x29, 0x52, 0x4a, 0x4f, 0x75,
+	0xd1, 0xc4, 0xad, 0xe0, 0x7d, 0xd4, 0x8b, 0xa2, 0xae, 0xb4, 0x82, 0xe2, 0x45, 0xb2, 0xdd, 0xd8,
+	0x16, 0xba, 0x7d, 0xd9, 0xa4, 0xb3, 0x33, 0x52, 0x06, 0xc4, 0x4f, 0x20, 0x08, 0x1e, 0xfd, 0x3c,
+	0x1e, 0x07, 0xbc, 0x78, 0x94, 0x19, 0x3f, 0x88, 0xa4, 0xd1, 0x61, 0x64, 0xe6, 0xe0, 0xa1, 0xe5,
+	0xbd, 0xc7, 0x3f, 0xff, 0xfc, 0xfe, 0xe1, 0xe1, 0x30, 0x17, 0xb5, 0xd0, 0x6d, 0xc5, 0x59, 0x0e,
+	0x4a, 0xb0, 0xcb, 0x63, 0x76, 0xc6, 0x35, 0xbb, 0x18, 0x0b, 0xf5, 0x9e, 0x4a, 0x05, 0x2d, 0x90,
+	0xc3, 0xbf, 0x0a, 0x6a, 0x14, 0xf4, 0xf2, 0x98, 0x9e, 0x71, 0xed, 0xdf, 0x2c, 0x00, 0x8a, 0x5a,
+	0x30, 0x2e, 0x2b, 0xc6, 0x9b, 0x06, 0x5a, 0xde, 0x56, 0xd0, 0x68, 0x7b, 0xc6, 0x8f, 0x36, 0x5c,
+	0xa5, 0x02, 0x78, 0x67, 0xff, 0x56, 0x13, 0x7d, 0x40, 0x98, 0x64, 0xfc, 0x5c, 0xd6, 0x22, 0x2b,
+	0xb9, 0x12, 0xa9, 0xb8, 0x18, 0x0b, 0xdd, 0x92, 0x1b, 0x78, 0xaf, 0x14, 0x55, 0x51, 0xb6, 0x1e,
+	0x0a, 0x51, 0xec, 0xa6, 0x7f, 0x3a, 0x32, 0xc4, 0xae, 0x82, 0x89, 0xb7, 0x13, 0xa2, 0xf8, 0x6a,
+	0x6a, 0x4a, 0x33, 0xc9, 0xa1, 0xf6, 0x5c, 0x3b, 0xc9, 0xa1, 0x26, 0x14, 0x0f, 0xf8, 0xb4, 0xd2,
+	0xde, 0x20, 0x44, 0xf1, 0xb5, 0xc4, 0xa7, 0xdb, 0xc8, 0xe9, 0x68, 0x5a, 0xe9, 0xb4, 0xd7, 0x45,
+	0x1d, 0xbe, 0xfe, 0x0f, 0x81, 0x96, 0xd0, 0x68, 0x41, 0x0e, 0xf1, 0xae, 0x36, 0x83, 0x9e, 0xe0,
+	0x20, 0xb5, 0x0d, 0xb9, 0x8f, 0x77, 0x7b, 0xfc, 0x1e, 0x61, 0x3f, 0x09, 0x37, 0xdd, 0x6d, 0xba,
+	0xe7, 0xcf, 0x5e, 0xbe, 0x30, 0x45, 0x6a, 0xe5, 0x6b, 0x81, 0xdc, 0xf5, 0x40, 0x47, 0x11, 0x1e,
+	0x18, 0x14, 0x72, 0x80, 0xaf, 0x8c, 0x5e, 0x3f, 0xce, 0xde, 0xa6, 0x27, 0xaf, 0x86, 0xce, 0xaa,
+	0x7b, 0x78, 0xf2, 0x74, 0x88, 0x92, 0xaf, 0x08, 0xbb, 0x8f, 0x46, 0x19, 0xf9, 0x82, 0xf0, 0xfe,
+	0x1a, 0x29, 0x89, 0xb7, 0x47, 0xdb, 0x7c, 0x4e, 0xff, 0xd6, 0x7f, 0x28, 0x6d, 0xec, 0x28, 0xf9,
+	0xf8, 0xfd, 0xd7, 0xe7, 0x9d, 0xdb, 0xe4, 0x88, 0x6d, 0xdd, 0x89, 0xce, 0x72, 0xcf, 0x58, 0xa7,
+	0x60, 0x32, 0x63, 0x5d, 0x0e, 0xf5, 0xec, 0xc1, 0x93, 0x6f, 0x8b, 0x00, 0xcd, 0x17, 0x01, 0xfa,
+	0xb9, 0x08, 0xd0, 0xa7, 0x65, 0xe0, 0xcc, 0x97, 0x81, 0xf3, 0x63, 0x19, 0x38, 0x6f, 0xee, 0x16,
+	0x55, 0x5b, 0x8e, 0x4f, 0x69, 0x0e, 0xe7, 0x2b, 0x3f, 0x50, 0xc5, 0xaa, 0xbe, 0xc3, 0xa5, 0x64,
+	0xe6, 0x2b, 0x94, 0xcc, 0xcd, 0x05, 0xa7, 0x7b, 0xfd, 0x5e, 0xdc, 0xfb, 0x3d, 0x00, 0x89, 0xc7,
+	0xe7, 0x55, 0x93, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DASClient is the client API for DAS service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DASClient interface {
+	// SampleShare returns a share of the extended data square of a block along
+	// with its NMT proof to the root of its row or column.
+	SampleShare(ctx context.Context, in *SampleShareRequest, opts ...grpc.CallOption) (*SampleShareResponse, error)
+}
+
+type dASClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewDASClient(cc grpc1.ClientConn) DASClient {
+	return &dASClient{cc}
+}
+
+func (c *dASClient) SampleShare(ctx context.Context, in *SampleShareRequest, opts ...grpc.CallOption) (*SampleShareResponse, error) {
+	out := new(SampleShareResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.das.DAS/SampleShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DASServer is the server API for DAS service.
+type DASServer interface {
+	// SampleShare returns a share of the extended data square of a block along
+	// with its NMT proof to the root of its row or column.
+	SampleShare(context.Context, *SampleShareRequest) (*SampleShareResponse, error)
+}
+
+// UnimplementedDASServer can be embedded to have forward compatible implementations.
+type UnimplementedDASServer struct {
+}
+
+func (*UnimplementedDASServer) SampleShare(ctx context.Context, req *SampleShareRequest) (*SampleShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SampleShare not implemented")
+}
+
+func RegisterDASServer(s grpc1.Server, srv DASServer) {
+	s.RegisterService(&_DAS_serviceDesc, srv)
+}
+
+func _DAS_SampleShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SampleShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).SampleShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.das.DAS/SampleShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).SampleShare(ctx, req.(*SampleShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DAS_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.das.DAS",
+	HandlerType: (*DASServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SampleShare",
+			Handler:    _DAS_SampleShare_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/das/query.proto",
+}
+
+func (m *SampleShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SampleShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SampleShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Axis != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Axis))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Col != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Col))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Row != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SampleShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SampleShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SampleShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SampleShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Row != 0 {
+		n += 1 + sovQuery(uint64(m.Row))
+	}
+	if m.Col != 0 {
+		n += 1 + sovQuery(uint64(m.Col))
+	}
+	if m.Axis != 0 {
+		n += 1 + sovQuery(uint64(m.Axis))
+	}
+	return n
+}
+
+func (m *SampleShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SampleShareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SampleShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SampleShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			m.Col = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Col |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Axis", wireType)
+			}
+			m.Axis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Axis |= Axis(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SampleShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SampleShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SampleShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &proof.NMTProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,183 @@
+package das
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/das"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	coretypes "github.com/tendermint/tendermint/types"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// RegisterDASService registers the DAS service on the gRPC router. The
+// service reuses the extended data squares of the cache. A nil cache disables
+// caching.
+func RegisterDASService(qrt gogogrpc.Server, clientCtx client.Context, cache *da.Cache) {
+	RegisterDASServer(
+		qrt,
+		NewDASServer(clientCtx, cache),
+	)
+}
+
+// RegisterGRPCGatewayRoutes mounts the DAS service's GRPC-gateway routes on
+// the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterDASHandlerClient(context.Background(), mux, NewDASClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ DASServer = &dasServer{}
+
+type dasServer struct {
+	clientCtx client.Context
+	cache     *da.Cache
+}
+
+func NewDASServer(clientCtx client.Context, cache *da.Cache) DASServer {
+	return &dasServer{
+		clientCtx: clientCtx,
+		cache:     cache,
+	}
+}
+
+// SampleShare implements the DASServer.SampleShare method. It fetches the
+// block from the underlying celestia-core RPC server and extends its square to
+// prove the requested share, unless the square of the block is cached.
+func (s *dasServer) SampleShare(ctx context.Context, req *SampleShareRequest) (*SampleShareResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d cannot be negative", req.Height)
+	}
+	axis, err := toRsmt2dAxis(req.Axis)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	var height *int64
+	if req.Height != 0 {
+		height = &req.Height
+	}
+	resBlock, err := node.Block(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	eds, err := extendBlock(s.cache, resBlock.Block)
+	if err != nil {
+		return nil, err
+	}
+	if uint(req.Row) >= eds.Width() || uint(req.Col) >= eds.Width() {
+		return nil, status.Errorf(codes.InvalidArgument, "coordinate (%d, %d) out of bounds of an extended data square of width %d", req.Row, req.Col, eds.Width())
+	}
+	sampled, err := das.ProveShare(eds, das.Coordinate{Row: uint(req.Row), Col: uint(req.Col), Axis: axis})
+	if err != nil {
+		return nil, err
+	}
+	return &SampleShareResponse{
+		Share:  sampled.Share,
+		Proof:  sampled.Proof,
+		Height: resBlock.Block.Height,
+	}, nil
+}
+
+// extendBlock reconstructs the square of the block and extends it, reusing
+// the square of the cache if the cache holds the square of the block. As the
+// application's state isn't available, the upper bound square size is used
+// instead of the square size dictated by governance. The extended square is
+// cached if its data root is the one of the block.
+func extendBlock(cache *da.Cache, block *coretypes.Block) (*rsmt2d.ExtendedDataSquare, error) {
+	if eds, _, ok := cache.Get(block.Height, block.DataHash); ok {
+		return eds, nil
+	}
+
+	appVersion := block.Header.Version.App
+	dataSquare, err := square.Construct(
+		block.Data.Txs.ToSliceOfBytes(),
+		appconsts.SquareSizeUpperBound(appVersion),
+		appconsts.SubtreeRootThreshold(appVersion),
+	)
+	if err != nil {
+		return nil, err
+	}
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return nil, err
+	}
+	// computing the data availability header computes the roots of the
+	// square, so that the cached square is only read from then on.
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(dah.Hash(), block.DataHash) {
+		cache.Add(block.Height, eds, dah)
+	}
+	return eds, nil
+}
+
+func toRsmt2dAxis(axis Axis) (rsmt2d.Axis, error) {
+	switch axis {
+	case Axis_AXIS_ROW:
+		return rsmt2d.Row, nil
+	case Axis_AXIS_COL:
+		return rsmt2d.Col, nil
+	default:
+		return 0, status.Errorf(codes.InvalidArgument, "invalid axis %d", axis)
+	}
+}
+
+var _ das.ShareGetter = &ShareGetter{}
+
+// ShareGetter fetches the shares of the extended data square of a block from
+// the DAS service of a node.
+type ShareGetter struct {
+	client DASClient
+	height int64
+}
+
+// NewShareGetter returns a share getter fetching the shares of the block at
+// height using the client.
+func NewShareGetter(client DASClient, height int64) *ShareGetter {
+	return &ShareGetter{
+		client: client,
+		height: height,
+	}
+}
+
+// GetShare implements the das.ShareGetter.GetShare method.
+func (g *ShareGetter) GetShare(ctx context.Context, coord das.Coordinate) (das.SampledShare, error) {
+	axis := Axis_AXIS_ROW
+	if coord.Axis == rsmt2d.Col {
+		axis = Axis_AXIS_COL
+	}
+	resp, err := g.client.SampleShare(ctx, &SampleShareRequest{
+		Height: g.height,
+		Row:    uint32(coord.Row),
+		Col:    uint32(coord.Col),
+		Axis:   axis,
+	})
+	if err != nil {
+		return das.SampledShare{}, err
+	}
+	return das.SampledShare{
+		Share: resp.Share,
+		Proof: resp.Proof,
+	}, nil
+}
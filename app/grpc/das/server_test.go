@@ -0,0 +1,43 @@
+package das
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+)
+
+func TestExtendBlockReusesCachedSquare(t *testing.T) {
+	block := &coretypes.Block{
+		Header: coretypes.Header{Height: 10},
+		Data:   coretypes.Data{Txs: coretypes.ToTxs(testfactory.GenerateRandomTxs(20, 500).ToSliceOfBytes())},
+	}
+	block.Header.Version.App = appconsts.LatestVersion
+	eds, err := extendBlock(nil, block)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	block.DataHash = dah.Hash()
+
+	cache := da.NewCache(da.DefaultCacheSize)
+	_, err = extendBlock(cache, block)
+	require.NoError(t, err)
+	cached, err := extendBlock(cache, block)
+	require.NoError(t, err)
+	assert.Equal(t, eds.Flattened(), cached.Flattened())
+	assert.Equal(t, da.CacheStats{Hits: 1, Misses: 1, Entries: 1, Size: int(eds.Width()*eds.Width()) * share.ShareSize}, cache.Stats())
+
+	// a block whose data hash doesn't match its square isn't cached
+	block.Height = 11
+	block.DataHash = bytes.Repeat([]byte{1}, 32)
+	_, err = extendBlock(cache, block)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cache.Stats().Entries)
+}
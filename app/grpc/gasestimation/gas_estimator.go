@@ -9,7 +9,6 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
-	blobtx "github.com/celestiaorg/go-square/v2/tx"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogogrpc "github.com/gogo/protobuf/grpc"
@@ -23,6 +22,9 @@ const EstimationZScore = 1.28
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// maxSquareSizeFn is the signature of the App#MaxEffectiveSquareSize function.
+type maxSquareSizeFn func(ctx sdk.Context) int
+
 // RegisterGasEstimationService registers the gas estimation service on the gRPC router.
 func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, simulateFn baseAppSimulateFn) {
 	RegisterGasEstimatorServer(
@@ -31,17 +33,41 @@ func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context,
 	)
 }
 
+// RegisterGasEstimationServiceWithState registers the gas estimation service
+// on the gRPC router. The estimates use the max effective square size and the
+// network min gas price of the state the queries are made against.
+func RegisterGasEstimationServiceWithState(qrt gogogrpc.Server, clientCtx client.Context, simulateFn baseAppSimulateFn, maxSquareSizeFn maxSquareSizeFn, minFeeServer minfee.QueryServer) {
+	RegisterGasEstimatorServer(
+		qrt,
+		NewGasEstimatorServerWithState(clientCtx, simulateFn, maxSquareSizeFn, minFeeServer),
+	)
+}
+
 var _ GasEstimatorServer = &gasEstimatorServer{}
 
 type gasEstimatorServer struct {
-	clientCtx  client.Context
-	simulateFn baseAppSimulateFn
+	clientCtx       client.Context
+	simulateFn      baseAppSimulateFn
+	maxSquareSizeFn maxSquareSizeFn
+	minFeeServer    minfee.QueryServer
 }
 
+// NewGasEstimatorServer returns a gas estimator server that uses the default
+// max square size and network min gas price.
 func NewGasEstimatorServer(clientCtx client.Context, simulateFn baseAppSimulateFn) GasEstimatorServer {
+	return NewGasEstimatorServerWithState(clientCtx, simulateFn, nil, nil)
+}
+
+// NewGasEstimatorServerWithState returns a gas estimator server that reads the
+// max effective square size and the network min gas price from the state the
+// queries are made against. Either function may be nil, in which case the
+// default value is used.
+func NewGasEstimatorServerWithState(clientCtx client.Context, simulateFn baseAppSimulateFn, maxSquareSizeFn maxSquareSizeFn, minFeeServer minfee.QueryServer) GasEstimatorServer {
 	return &gasEstimatorServer{
-		clientCtx:  clientCtx,
-		simulateFn: simulateFn,
+		clientCtx:       clientCtx,
+		simulateFn:      simulateFn,
+		maxSquareSizeFn: maxSquareSizeFn,
+		minFeeServer:    minFeeServer,
 	}
 }
 
@@ -55,17 +81,25 @@ func lastFiveBlocksTransactionsQuery(latestHeight int64) string {
 	return fmt.Sprintf("tx.height>%d AND tx.height<=%d", startHeight, latestHeight)
 }
 
+// defaultTxShares is the number of shares assumed to be occupied by the
+// transaction a gas price is estimated for if it isn't provided.
+const defaultTxShares = 1
+
 // numberOfTransactionsPerPage the number of transactions to return per page in the transaction search
 // endpoint.
 // Note: the maximum number of transactions per page the endpoint allows is 100.
 var numberOfTransactionsPerPage = 100
 
 func (s *gasEstimatorServer) EstimateGasPrice(ctx context.Context, request *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error) {
-	gasPrice, err := s.estimateGasPrice(ctx, request.TxPriority)
+	estimate, err := s.estimateGasPrice(ctx, request.TxPriority, defaultTxShares)
 	if err != nil {
 		return nil, err
 	}
-	return &EstimateGasPriceResponse{EstimatedGasPrice: gasPrice}, nil
+	return &EstimateGasPriceResponse{
+		EstimatedGasPrice:    estimate.gasPrice,
+		InclusionProbability: estimate.inclusionProbability,
+		ExpectedWaitBlocks:   estimate.expectedWaitBlocks,
+	}, nil
 }
 
 // EstimateGasPriceAndUsage takes a transaction priority and a transaction bytes
@@ -76,47 +110,107 @@ func (s *gasEstimatorServer) EstimateGasPrice(ctx context.Context, request *Esti
 // to the minimum gas price set by that node.
 // The gas used is estimated using the state machine simulation.
 func (s *gasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, request *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
+	txBytes, shares, err := txShares(request.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
 	// estimate the gas price
-	gasPrice, err := s.estimateGasPrice(ctx, request.TxPriority)
+	estimate, err := s.estimateGasPrice(ctx, request.TxPriority, shares)
 	if err != nil {
 		return nil, err
 	}
 
 	// estimate the gas used
-	btx, isBlob, err := blobtx.UnmarshalBlobTx(request.TxBytes)
-	if isBlob && err != nil {
+	gasUsedInfo, _, err := s.simulateFn(txBytes)
+	if err != nil {
 		return nil, err
 	}
+	return &EstimateGasPriceAndUsageResponse{
+		EstimatedGasPrice:    estimate.gasPrice,
+		EstimatedGasUsed:     gasUsedInfo.GasUsed,
+		InclusionProbability: estimate.inclusionProbability,
+		ExpectedWaitBlocks:   estimate.expectedWaitBlocks,
+	}, nil
+}
+
+// gasPriceEstimate is an estimated gas price along with the expected
+// inclusion of a transaction paying it.
+type gasPriceEstimate struct {
+	gasPrice             float64
+	inclusionProbability float64
+	expectedWaitBlocks   uint64
+}
 
-	var txBytes []byte
-	if isBlob {
-		txBytes = btx.Tx
-	} else {
-		txBytes = request.TxBytes
+// estimateGasPrice takes a transaction priority and estimates the gas price
+// of a transaction occupying txShares shares. The estimate is based on the gas
+// prices of the transactions in the last five blocks, raised if needed to be
+// ahead of the mempool transactions that don't fit in the blocks targeted by
+// the priority.
+func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPriority, txShares float64) (gasPriceEstimate, error) {
+	estimates, err := s.estimateGasPrices(ctx, []TxPriority{priority}, txShares)
+	if err != nil {
+		return gasPriceEstimate{}, err
 	}
+	return estimates[0], nil
+}
 
-	gasUsedInfo, _, err := s.simulateFn(txBytes)
+// estimateGasPrices estimates the gas price of a transaction occupying
+// txShares shares for each of the priorities, in the same order. The chain and
+// the mempool are only queried once for all of them.
+func (s *gasEstimatorServer) estimateGasPrices(ctx context.Context, priorities []TxPriority, txShares float64) ([]gasPriceEstimate, error) {
+	status, err := s.clientCtx.Client.Status(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &EstimateGasPriceAndUsageResponse{
-		EstimatedGasPrice: gasPrice,
-		EstimatedGasUsed:  gasUsedInfo.GasUsed,
-	}, nil
+	latestHeight := status.SyncInfo.LatestBlockHeight
+
+	gasPrices, err := s.recentGasPrices(ctx, latestHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// the estimate falls back to the gas prices of the last blocks if the
+	// mempool can't be queried, in which case the inclusion is unknown.
+	mempool, err := s.mempoolState(ctx)
+	if err != nil {
+		mempool = mempoolState{}
+	}
+
+	estimates := make([]gasPriceEstimate, 0, len(priorities))
+	for _, priority := range priorities {
+		gasPrice, err := historicalGasPrice(gasPrices, priority)
+		if err != nil {
+			return nil, err
+		}
+		gasPrice = max(gasPrice, mempool.clearingGasPrice(targetInclusionBlocks(priority), txShares))
+		probability, waitBlocks := mempool.inclusion(gasPrice, txShares)
+		estimates = append(estimates, gasPriceEstimate{
+			gasPrice:             gasPrice,
+			inclusionProbability: probability,
+			expectedWaitBlocks:   waitBlocks,
+		})
+	}
+	return estimates, nil
 }
 
-// estimateGasPrice takes a transaction priority and estimates the gas price based
-// on the gas prices of the transactions in the last five blocks.
+// historicalGasPrice takes the gas prices of the transactions in the last five
+// blocks and a transaction priority and estimates the gas price.
 // If no transaction is found in the last five blocks, return the network
 // min gas price.
 // It's up to the light client to set the gas price in this case
 // to the minimum gas price set by that node.
-func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPriority) (float64, error) {
-	status, err := s.clientCtx.Client.Status(ctx)
-	if err != nil {
-		return 0, err
+func historicalGasPrice(gasPrices []float64, priority TxPriority) (float64, error) {
+	if len(gasPrices) == 0 {
+		// return the min gas price if no transaction found in the last 5 blocks
+		return minfee.DefaultNetworkMinGasPrice.MustFloat64(), nil
 	}
-	latestHeight := status.SyncInfo.LatestBlockHeight
+	return estimateGasPriceForTransactions(gasPrices, priority)
+}
+
+// recentGasPrices returns the gas prices of the transactions in the last five
+// blocks.
+func (s *gasEstimatorServer) recentGasPrices(ctx context.Context, latestHeight int64) ([]float64, error) {
 	page := 1
 	txSearchResult, err := s.clientCtx.Client.TxSearch(
 		ctx,
@@ -127,20 +221,19 @@ func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPr
 		"asc",
 	)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	totalNumberOfTransactions := txSearchResult.TotalCount
 	if totalNumberOfTransactions == 0 {
-		// return the min gas price if no transaction found in the last 5 blocks
-		return minfee.DefaultNetworkMinGasPrice.MustFloat64(), nil
+		return nil, nil
 	}
 
 	gasPrices := make([]float64, 0)
 	for {
 		currentPageGasPrices, err := extractGasPriceFromTransactions(txSearchResult.Txs)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		gasPrices = append(gasPrices, currentPageGasPrices...)
 		if len(gasPrices) >= totalNumberOfTransactions {
@@ -156,10 +249,10 @@ func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPr
 			"asc",
 		)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 	}
-	return estimateGasPriceForTransactions(gasPrices, priority)
+	return gasPrices, nil
 }
 
 // estimateGasPriceForTransactions takes a list of transactions and priority
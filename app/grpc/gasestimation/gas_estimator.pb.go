@@ -36,6 +36,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // - Medium Priority: The gas price is the mean of all gas prices from the last 5 blocks.
 // - Low Priority: The gas price is the value at the end of the lowest 10% of gas prices from the last 5 blocks.
 // - Unspecified Priority (default): This is equivalent to the Medium priority, using the mean of all gas prices from the last 5 blocks.
+// Regardless of the gas prices of the last 5 blocks, the gas price is high
+// enough for the transaction to be ahead of the transactions in the mempool
+// that fill the next block for High priority, the next 2 blocks for Medium
+// priority and the next 3 blocks for Low priority.
 type TxPriority int32
 
 const (
@@ -72,6 +76,39 @@ func (TxPriority) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_67d02876d749b9cc, []int{0}
 }
 
+// SignerType is the type of the key signing the quoted transaction.
+type SignerType int32
+
+const (
+	// SIGNER_TYPE_UNSPECIFIED the default signer type, which is equivalent to
+	// SIGNER_TYPE_SECP256K1.
+	SignerType_SIGNER_TYPE_UNSPECIFIED SignerType = 0
+	// SIGNER_TYPE_SECP256K1 secp256k1 key.
+	SignerType_SIGNER_TYPE_SECP256K1 SignerType = 1
+	// SIGNER_TYPE_SECP256R1 secp256r1 key.
+	SignerType_SIGNER_TYPE_SECP256R1 SignerType = 2
+)
+
+var SignerType_name = map[int32]string{
+	0: "SIGNER_TYPE_UNSPECIFIED",
+	1: "SIGNER_TYPE_SECP256K1",
+	2: "SIGNER_TYPE_SECP256R1",
+}
+
+var SignerType_value = map[string]int32{
+	"SIGNER_TYPE_UNSPECIFIED": 0,
+	"SIGNER_TYPE_SECP256K1":   1,
+	"SIGNER_TYPE_SECP256R1":   2,
+}
+
+func (x SignerType) String() string {
+	return proto.EnumName(SignerType_name, int32(x))
+}
+
+func (SignerType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{1}
+}
+
 // EstimateGasPriceRequest the request to estimate the gas price of the network.
 // Takes a priority enum to define the priority level.
 type EstimateGasPriceRequest struct {
@@ -121,6 +158,14 @@ func (m *EstimateGasPriceRequest) GetTxPriority() TxPriority {
 // EstimateGasPriceResponse the response of the gas price estimation.
 type EstimateGasPriceResponse struct {
 	EstimatedGasPrice float64 `protobuf:"fixed64,1,opt,name=estimated_gas_price,json=estimatedGasPrice,proto3" json:"estimated_gas_price,omitempty"`
+	// inclusion_probability is the probability, given the current mempool, that
+	// a transaction paying the estimated gas price is included in the next block.
+	// It is zero if the mempool of the node can't be queried.
+	InclusionProbability float64 `protobuf:"fixed64,2,opt,name=inclusion_probability,json=inclusionProbability,proto3" json:"inclusion_probability,omitempty"`
+	// expected_wait_blocks is the number of blocks, given the current mempool,
+	// until a transaction paying the estimated gas price is included.
+	// It is zero if the mempool of the node can't be queried.
+	ExpectedWaitBlocks uint64 `protobuf:"varint,3,opt,name=expected_wait_blocks,json=expectedWaitBlocks,proto3" json:"expected_wait_blocks,omitempty"`
 }
 
 func (m *EstimateGasPriceResponse) Reset()         { *m = EstimateGasPriceResponse{} }
@@ -163,6 +208,20 @@ func (m *EstimateGasPriceResponse) GetEstimatedGasPrice() float64 {
 	return 0
 }
 
+func (m *EstimateGasPriceResponse) GetInclusionProbability() float64 {
+	if m != nil {
+		return m.InclusionProbability
+	}
+	return 0
+}
+
+func (m *EstimateGasPriceResponse) GetExpectedWaitBlocks() uint64 {
+	if m != nil {
+		return m.ExpectedWaitBlocks
+	}
+	return 0
+}
+
 // EstimateGasPriceAndUsageRequest the request to estimate the gas price of the network
 // and also the gas used for the provided transaction.
 type EstimateGasPriceAndUsageRequest struct {
@@ -222,6 +281,15 @@ func (m *EstimateGasPriceAndUsageRequest) GetTxBytes() []byte {
 type EstimateGasPriceAndUsageResponse struct {
 	EstimatedGasPrice float64 `protobuf:"fixed64,1,opt,name=estimated_gas_price,json=estimatedGasPrice,proto3" json:"estimated_gas_price,omitempty"`
 	EstimatedGasUsed  uint64  `protobuf:"varint,2,opt,name=estimated_gas_used,json=estimatedGasUsed,proto3" json:"estimated_gas_used,omitempty"`
+	// inclusion_probability is the probability, given the current mempool, that
+	// the transaction is included in the next block if it pays the estimated gas
+	// price.
+	// It is zero if the mempool of the node can't be queried.
+	InclusionProbability float64 `protobuf:"fixed64,3,opt,name=inclusion_probability,json=inclusionProbability,proto3" json:"inclusion_probability,omitempty"`
+	// expected_wait_blocks is the number of blocks, given the current mempool,
+	// until the transaction is included if it pays the estimated gas price.
+	// It is zero if the mempool of the node can't be queried.
+	ExpectedWaitBlocks uint64 `protobuf:"varint,4,opt,name=expected_wait_blocks,json=expectedWaitBlocks,proto3" json:"expected_wait_blocks,omitempty"`
 }
 
 func (m *EstimateGasPriceAndUsageResponse) Reset()         { *m = EstimateGasPriceAndUsageResponse{} }
@@ -271,12 +339,265 @@ func (m *EstimateGasPriceAndUsageResponse) GetEstimatedGasUsed() uint64 {
 	return 0
 }
 
+func (m *EstimateGasPriceAndUsageResponse) GetInclusionProbability() float64 {
+	if m != nil {
+		return m.InclusionProbability
+	}
+	return 0
+}
+
+func (m *EstimateGasPriceAndUsageResponse) GetExpectedWaitBlocks() uint64 {
+	if m != nil {
+		return m.ExpectedWaitBlocks
+	}
+	return 0
+}
+
+// QuoteBlobSubmissionRequest the request to quote the submission of a set of
+// blobs.
+type QuoteBlobSubmissionRequest struct {
+	// blob_sizes are the sizes in bytes of the blobs to submit.
+	BlobSizes []uint32 `protobuf:"varint,1,rep,packed,name=blob_sizes,json=blobSizes,proto3" json:"blob_sizes,omitempty"`
+	// namespaces are the optional namespaces of the blobs. If set, there must be
+	// one valid blob namespace per blob.
+	Namespaces [][]byte `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// signer_type is the type of the key signing the transaction.
+	SignerType SignerType `protobuf:"varint,3,opt,name=signer_type,json=signerType,proto3,enum=celestia.core.v1.gas_estimation.SignerType" json:"signer_type,omitempty"`
+}
+
+func (m *QuoteBlobSubmissionRequest) Reset()         { *m = QuoteBlobSubmissionRequest{} }
+func (m *QuoteBlobSubmissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuoteBlobSubmissionRequest) ProtoMessage()    {}
+func (*QuoteBlobSubmissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{4}
+}
+func (m *QuoteBlobSubmissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuoteBlobSubmissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuoteBlobSubmissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuoteBlobSubmissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteBlobSubmissionRequest.Merge(m, src)
+}
+func (m *QuoteBlobSubmissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuoteBlobSubmissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteBlobSubmissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteBlobSubmissionRequest proto.InternalMessageInfo
+
+func (m *QuoteBlobSubmissionRequest) GetBlobSizes() []uint32 {
+	if m != nil {
+		return m.BlobSizes
+	}
+	return nil
+}
+
+func (m *QuoteBlobSubmissionRequest) GetNamespaces() [][]byte {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *QuoteBlobSubmissionRequest) GetSignerType() SignerType {
+	if m != nil {
+		return m.SignerType
+	}
+	return SignerType_SIGNER_TYPE_UNSPECIFIED
+}
+
+// QuoteBlobSubmissionResponse the quote of the submission of a set of blobs.
+type QuoteBlobSubmissionResponse struct {
+	// gas_limit is the gas the transaction paying for the blobs should be
+	// submitted with. It is the estimated gas increased by the gas multiplier
+	// of the TxClient.
+	GasLimit uint64 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// blob_shares are the number of shares each blob occupies, in the order of
+	// the request.
+	BlobShares []uint32 `protobuf:"varint,2,rep,packed,name=blob_shares,json=blobShares,proto3" json:"blob_shares,omitempty"`
+	// network_min_gas_price is the minimum gas price accepted by the network.
+	NetworkMinGasPrice float64 `protobuf:"fixed64,3,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3" json:"network_min_gas_price,omitempty"`
+	// min_fee is the fee, in utia, paid at the network minimum gas price.
+	MinFee uint64 `protobuf:"varint,4,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	// recommended_fees are the fees recommended for each priority.
+	RecommendedFees []*BlobSubmissionFee `protobuf:"bytes,5,rep,name=recommended_fees,json=recommendedFees,proto3" json:"recommended_fees,omitempty"`
+}
+
+func (m *QuoteBlobSubmissionResponse) Reset()         { *m = QuoteBlobSubmissionResponse{} }
+func (m *QuoteBlobSubmissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuoteBlobSubmissionResponse) ProtoMessage()    {}
+func (*QuoteBlobSubmissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{5}
+}
+func (m *QuoteBlobSubmissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuoteBlobSubmissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuoteBlobSubmissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuoteBlobSubmissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteBlobSubmissionResponse.Merge(m, src)
+}
+func (m *QuoteBlobSubmissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuoteBlobSubmissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteBlobSubmissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteBlobSubmissionResponse proto.InternalMessageInfo
+
+func (m *QuoteBlobSubmissionResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *QuoteBlobSubmissionResponse) GetBlobShares() []uint32 {
+	if m != nil {
+		return m.BlobShares
+	}
+	return nil
+}
+
+func (m *QuoteBlobSubmissionResponse) GetNetworkMinGasPrice() float64 {
+	if m != nil {
+		return m.NetworkMinGasPrice
+	}
+	return 0
+}
+
+func (m *QuoteBlobSubmissionResponse) GetMinFee() uint64 {
+	if m != nil {
+		return m.MinFee
+	}
+	return 0
+}
+
+func (m *QuoteBlobSubmissionResponse) GetRecommendedFees() []*BlobSubmissionFee {
+	if m != nil {
+		return m.RecommendedFees
+	}
+	return nil
+}
+
+// BlobSubmissionFee the recommended fee of a blob submission for a priority.
+type BlobSubmissionFee struct {
+	TxPriority TxPriority `protobuf:"varint,1,opt,name=tx_priority,json=txPriority,proto3,enum=celestia.core.v1.gas_estimation.TxPriority" json:"tx_priority,omitempty"`
+	GasPrice   float64    `protobuf:"fixed64,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// fee is the fee, in utia, paid at the gas price.
+	Fee uint64 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// inclusion_probability is the probability, given the current mempool, that
+	// the transaction is included in the next block if it pays the fee.
+	// It is zero if the mempool of the node can't be queried.
+	InclusionProbability float64 `protobuf:"fixed64,4,opt,name=inclusion_probability,json=inclusionProbability,proto3" json:"inclusion_probability,omitempty"`
+	// expected_wait_blocks is the number of blocks, given the current mempool,
+	// until the transaction is included if it pays the fee.
+	// It is zero if the mempool of the node can't be queried.
+	ExpectedWaitBlocks uint64 `protobuf:"varint,5,opt,name=expected_wait_blocks,json=expectedWaitBlocks,proto3" json:"expected_wait_blocks,omitempty"`
+}
+
+func (m *BlobSubmissionFee) Reset()         { *m = BlobSubmissionFee{} }
+func (m *BlobSubmissionFee) String() string { return proto.CompactTextString(m) }
+func (*BlobSubmissionFee) ProtoMessage()    {}
+func (*BlobSubmissionFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{6}
+}
+func (m *BlobSubmissionFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobSubmissionFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobSubmissionFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobSubmissionFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobSubmissionFee.Merge(m, src)
+}
+func (m *BlobSubmissionFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobSubmissionFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobSubmissionFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobSubmissionFee proto.InternalMessageInfo
+
+func (m *BlobSubmissionFee) GetTxPriority() TxPriority {
+	if m != nil {
+		return m.TxPriority
+	}
+	return TxPriority_TX_PRIORITY_UNSPECIFIED
+}
+
+func (m *BlobSubmissionFee) GetGasPrice() float64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *BlobSubmissionFee) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *BlobSubmissionFee) GetInclusionProbability() float64 {
+	if m != nil {
+		return m.InclusionProbability
+	}
+	return 0
+}
+
+func (m *BlobSubmissionFee) GetExpectedWaitBlocks() uint64 {
+	if m != nil {
+		return m.ExpectedWaitBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("celestia.core.v1.gas_estimation.TxPriority", TxPriority_name, TxPriority_value)
+	proto.RegisterEnum("celestia.core.v1.gas_estimation.SignerType", SignerType_name, SignerType_value)
 	proto.RegisterType((*EstimateGasPriceRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceRequest")
 	proto.RegisterType((*EstimateGasPriceResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceResponse")
 	proto.RegisterType((*EstimateGasPriceAndUsageRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageRequest")
 	proto.RegisterType((*EstimateGasPriceAndUsageResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageResponse")
+	proto.RegisterType((*QuoteBlobSubmissionRequest)(nil), "celestia.core.v1.gas_estimation.QuoteBlobSubmissionRequest")
+	proto.RegisterType((*QuoteBlobSubmissionResponse)(nil), "celestia.core.v1.gas_estimation.QuoteBlobSubmissionResponse")
+	proto.RegisterType((*BlobSubmissionFee)(nil), "celestia.core.v1.gas_estimation.BlobSubmissionFee")
 }
 
 func init() {
@@ -284,37 +605,61 @@ func init() {
 }
 
 var fileDescriptor_67d02876d749b9cc = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbb, 0x6f, 0xd3, 0x40,
-	0x18, 0xf7, 0x05, 0x04, 0xe8, 0xa3, 0x02, 0x73, 0x45, 0x34, 0x0d, 0xc8, 0x8d, 0x3c, 0x55, 0x3c,
-	0x6c, 0xb5, 0x5d, 0x80, 0x89, 0x96, 0x9a, 0xd4, 0xa8, 0xa5, 0x91, 0x49, 0xc4, 0x63, 0xb1, 0x2e,
-	0xce, 0xe9, 0xb0, 0x94, 0xf8, 0x8e, 0xbb, 0x4b, 0xe5, 0x6e, 0xac, 0x30, 0xf1, 0x2f, 0xf0, 0x8f,
-	0x30, 0x33, 0x76, 0x64, 0x44, 0xc9, 0x3f, 0x82, 0x6c, 0xd7, 0xa9, 0x9b, 0xaa, 0xaa, 0x08, 0xea,
-	0x60, 0xe9, 0xbe, 0xc7, 0xef, 0xe1, 0xcf, 0x9f, 0x0f, 0x36, 0x22, 0x3a, 0xa0, 0x4a, 0xc7, 0xc4,
-	0x8d, 0xb8, 0xa4, 0xee, 0xc1, 0x9a, 0xcb, 0x88, 0x0a, 0xb3, 0xcc, 0x90, 0xe8, 0x98, 0x27, 0xd5,
-	0x90, 0x4b, 0x47, 0x48, 0xae, 0x39, 0x5e, 0x29, 0x41, 0x4e, 0x06, 0x72, 0x0e, 0xd6, 0x9c, 0xd3,
-	0xa0, 0xc6, 0x03, 0xc6, 0x39, 0x1b, 0x50, 0x97, 0x88, 0xd8, 0x25, 0x49, 0xc2, 0x75, 0x9e, 0x56,
-	0x05, 0xbc, 0xb1, 0x1c, 0x71, 0x35, 0xe4, 0x2a, 0xcc, 0x23, 0xb7, 0x08, 0x8a, 0x92, 0xcd, 0x60,
-	0xc9, 0x2b, 0x68, 0x68, 0x8b, 0xa8, 0xb6, 0x8c, 0x23, 0x1a, 0xd0, 0xcf, 0x23, 0xaa, 0x34, 0xde,
-	0x85, 0x9b, 0x3a, 0x0d, 0x85, 0x8c, 0xb9, 0x8c, 0xf5, 0x61, 0x1d, 0x35, 0xd1, 0xea, 0xad, 0xf5,
-	0x47, 0xce, 0x05, 0x56, 0x9c, 0x4e, 0xda, 0x3e, 0x86, 0x04, 0xa0, 0xa7, 0x67, 0xfb, 0x35, 0xd4,
-	0xcf, 0x0a, 0x29, 0xc1, 0x13, 0x45, 0xb1, 0x03, 0x8b, 0xc7, 0x04, 0xb4, 0x1f, 0x66, 0x74, 0x22,
-	0x2b, 0xe7, 0x8a, 0x28, 0xb8, 0x33, 0x2d, 0x95, 0x38, 0xfb, 0x1b, 0x82, 0x95, 0x59, 0xb2, 0xcd,
-	0xa4, 0xdf, 0x55, 0x84, 0x5d, 0x8e, 0x7b, 0xbc, 0x0c, 0x37, 0x74, 0x1a, 0xf6, 0x0e, 0x35, 0x55,
-	0xf5, 0x5a, 0x13, 0xad, 0x2e, 0x04, 0xd7, 0x75, 0xba, 0x95, 0x85, 0xf6, 0x17, 0x04, 0xcd, 0xf3,
-	0xcd, 0xcc, 0xf7, 0x86, 0xf8, 0x31, 0xe0, 0xd3, 0xfd, 0x23, 0x45, 0xfb, 0xb9, 0xf2, 0xd5, 0xc0,
-	0xac, 0xb6, 0x77, 0x15, 0xed, 0x3f, 0x1c, 0x00, 0x9c, 0xf8, 0xc6, 0xf7, 0x61, 0xa9, 0xf3, 0x3e,
-	0x6c, 0x07, 0xfe, 0x7e, 0xe0, 0x77, 0x3e, 0x84, 0xdd, 0x37, 0x6f, 0xdb, 0xde, 0x4b, 0xff, 0x95,
-	0xef, 0x6d, 0x9b, 0x06, 0x5e, 0x84, 0xdb, 0xd5, 0xe2, 0xee, 0xfe, 0x3b, 0x13, 0xe1, 0x7b, 0x80,
-	0xab, 0xc9, 0x3d, 0x6f, 0xdb, 0xef, 0xee, 0x99, 0x35, 0x7c, 0x17, 0xcc, 0x6a, 0x7e, 0xc7, 0x6f,
-	0xed, 0x98, 0x57, 0xd6, 0x7f, 0xd6, 0x60, 0xa1, 0x45, 0x94, 0x57, 0xee, 0x28, 0xfe, 0x8a, 0xc0,
-	0x9c, 0x9d, 0x00, 0x7e, 0x7a, 0xe1, 0xa8, 0xcf, 0xd9, 0xbb, 0xc6, 0xb3, 0x39, 0x90, 0xc5, 0x98,
-	0x6d, 0x03, 0xff, 0x40, 0x67, 0xf7, 0xac, 0xfc, 0x1a, 0xf8, 0xc5, 0x3f, 0x33, 0xcf, 0x6c, 0x55,
-	0x63, 0xf3, 0x3f, 0x18, 0x4a, 0x8f, 0x5b, 0x9d, 0x5f, 0x63, 0x0b, 0x1d, 0x8d, 0x2d, 0xf4, 0x67,
-	0x6c, 0xa1, 0xef, 0x13, 0xcb, 0x38, 0x9a, 0x58, 0xc6, 0xef, 0x89, 0x65, 0x7c, 0x7c, 0xce, 0x62,
-	0xfd, 0x69, 0xd4, 0x73, 0x22, 0x3e, 0x74, 0x4b, 0x21, 0x2e, 0xd9, 0xf4, 0xfc, 0x84, 0x08, 0xe1,
-	0x66, 0x0f, 0x93, 0x22, 0xca, 0x6e, 0x8a, 0x13, 0xe1, 0xde, 0xb5, 0xfc, 0x87, 0xde, 0xf8, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0xcb, 0xfa, 0xf9, 0x62, 0x61, 0x04, 0x00, 0x00,
+	// 849 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0x64, 0xdd, 0x36, 0x7d, 0x93, 0xd2, 0xed, 0x24, 0x21, 0x8e, 0x0b, 0x8e, 0xe5, 0x93,
+	0x55, 0xc0, 0xc6, 0x8e, 0x40, 0x7c, 0x1d, 0x68, 0x5a, 0x27, 0xb5, 0x48, 0x5a, 0x33, 0x76, 0x54,
+	0x8a, 0x84, 0x56, 0xbb, 0xeb, 0xb7, 0xdb, 0x51, 0x77, 0x77, 0x96, 0x9d, 0x71, 0xeb, 0xf0, 0x03,
+	0x90, 0xe0, 0x84, 0xf8, 0x07, 0x1c, 0x39, 0xf2, 0x2f, 0x38, 0x56, 0xe2, 0xc2, 0x11, 0x25, 0x07,
+	0x7e, 0x02, 0x57, 0x34, 0xbb, 0x5e, 0x67, 0x93, 0xc6, 0x0a, 0x18, 0x7a, 0x58, 0x69, 0xe6, 0x7d,
+	0xe6, 0x79, 0x3f, 0x9e, 0x77, 0x3e, 0x16, 0xb6, 0x5c, 0xf4, 0x51, 0x2a, 0x6e, 0x37, 0x5d, 0x11,
+	0x63, 0xf3, 0x59, 0xab, 0xe9, 0xd9, 0xd2, 0xd2, 0x96, 0xc0, 0x56, 0x5c, 0x84, 0xf9, 0xa9, 0x88,
+	0x1b, 0x51, 0x2c, 0x94, 0xa0, 0x9b, 0x19, 0xa9, 0xa1, 0x49, 0x8d, 0x67, 0xad, 0xc6, 0x69, 0x52,
+	0xf9, 0x0d, 0x4f, 0x08, 0xcf, 0xc7, 0xa6, 0x1d, 0xf1, 0xa6, 0x1d, 0x86, 0x42, 0x25, 0x66, 0x99,
+	0xd2, 0xcb, 0x1b, 0xae, 0x90, 0x81, 0x90, 0x56, 0x32, 0x6b, 0xa6, 0x93, 0x14, 0xaa, 0x79, 0xb0,
+	0xde, 0x49, 0xdd, 0xe0, 0xae, 0x2d, 0x7b, 0x31, 0x77, 0x91, 0xe1, 0xd7, 0x23, 0x94, 0x8a, 0xee,
+	0xc1, 0x92, 0x1a, 0x5b, 0x51, 0xcc, 0x45, 0xcc, 0xd5, 0x61, 0x89, 0x54, 0x49, 0xfd, 0xb5, 0xf6,
+	0x5b, 0x8d, 0x0b, 0x52, 0x69, 0x0c, 0xc6, 0xbd, 0x09, 0x85, 0x81, 0x9a, 0x8e, 0x6b, 0xbf, 0x10,
+	0x28, 0xbd, 0x1c, 0x49, 0x46, 0x22, 0x94, 0x48, 0x1b, 0xb0, 0x32, 0xf1, 0x80, 0x43, 0x4b, 0xfb,
+	0x8b, 0x34, 0x9c, 0x84, 0x24, 0xec, 0xc6, 0x14, 0xca, 0x78, 0x74, 0x0b, 0xd6, 0x78, 0xe8, 0xfa,
+	0x23, 0xc9, 0x45, 0xa8, 0xab, 0x72, 0x6c, 0x87, 0xfb, 0x3a, 0xc9, 0x85, 0x84, 0xb1, 0x3a, 0x05,
+	0x7b, 0x27, 0x18, 0x7d, 0x17, 0x56, 0x71, 0x1c, 0xa1, 0xab, 0x63, 0x3c, 0xb7, 0xb9, 0xb2, 0x1c,
+	0x5f, 0xb8, 0x4f, 0x65, 0xc9, 0xa8, 0x92, 0x7a, 0x91, 0xd1, 0x0c, 0x7b, 0x68, 0x73, 0xb5, 0x9d,
+	0x20, 0xb5, 0xef, 0x09, 0x6c, 0x9e, 0xcd, 0xf9, 0x76, 0x38, 0x3c, 0x90, 0xb6, 0xf7, 0x6a, 0x54,
+	0xa2, 0x1b, 0xb0, 0xa8, 0xc6, 0x96, 0x73, 0xa8, 0x50, 0x26, 0xb5, 0x2c, 0xb3, 0x2b, 0x6a, 0xbc,
+	0xad, 0xa7, 0xb5, 0x3f, 0x09, 0x54, 0x67, 0x27, 0x33, 0xa7, 0x90, 0x6f, 0x03, 0x3d, 0xbd, 0x7e,
+	0x24, 0x71, 0x98, 0x44, 0x2e, 0x32, 0x33, 0xbf, 0xfc, 0x40, 0xe2, 0x70, 0xb6, 0xec, 0xc6, 0x1c,
+	0xb2, 0x17, 0x67, 0xca, 0xfe, 0x33, 0x81, 0xf2, 0xe7, 0x23, 0xa1, 0x70, 0xdb, 0x17, 0x4e, 0x7f,
+	0xe4, 0x04, 0x5c, 0x6a, 0xa7, 0x99, 0xe2, 0x6f, 0x02, 0x38, 0xbe, 0x70, 0x2c, 0xc9, 0xbf, 0x41,
+	0x59, 0x22, 0x55, 0xa3, 0x7e, 0x8d, 0x5d, 0xd5, 0x96, 0xbe, 0x36, 0xd0, 0x0a, 0x40, 0x68, 0x07,
+	0x28, 0x23, 0xdb, 0x4d, 0x44, 0x34, 0xea, 0xcb, 0x2c, 0x67, 0xd1, 0x0d, 0x93, 0xdc, 0x0b, 0x31,
+	0xb6, 0xd4, 0x61, 0x84, 0x25, 0xe3, 0x1f, 0x36, 0xac, 0x9f, 0x70, 0x06, 0x87, 0x11, 0x32, 0x90,
+	0xd3, 0x71, 0xed, 0xdb, 0x05, 0xb8, 0x79, 0x6e, 0xae, 0x93, 0x86, 0xdc, 0x84, 0xab, 0xda, 0x91,
+	0xcf, 0x03, 0xae, 0x92, 0x36, 0x14, 0xd9, 0xa2, 0x67, 0xcb, 0x3d, 0x3d, 0xa7, 0x9b, 0xb0, 0x94,
+	0x56, 0xf2, 0xc4, 0x8e, 0x27, 0xb9, 0x5e, 0x63, 0x49, 0x71, 0xfd, 0xc4, 0x42, 0x5b, 0xb0, 0x16,
+	0xa2, 0x7a, 0x2e, 0xe2, 0xa7, 0x56, 0xc0, 0xc3, 0x5c, 0x43, 0x53, 0xc1, 0xe9, 0x04, 0xdc, 0xe7,
+	0xe1, 0xb4, 0xa3, 0xeb, 0x70, 0x45, 0x2f, 0x7d, 0x8c, 0x38, 0x51, 0xf8, 0x72, 0xc0, 0xc3, 0x1d,
+	0x44, 0xfa, 0x15, 0x98, 0x31, 0xba, 0x22, 0x08, 0x30, 0x1c, 0xe2, 0x50, 0x2f, 0x90, 0xa5, 0x4b,
+	0x55, 0xa3, 0xbe, 0xd4, 0x6e, 0x5f, 0x58, 0xfc, 0xe9, 0xe2, 0x76, 0x10, 0xd9, 0xf5, 0x9c, 0xaf,
+	0x1d, 0x44, 0x59, 0xfb, 0x8b, 0xc0, 0x8d, 0x97, 0x96, 0xfd, 0xcf, 0xa7, 0x63, 0x22, 0x66, 0x2a,
+	0x41, 0x7a, 0xd4, 0x17, 0xbd, 0xac, 0x70, 0x13, 0x8c, 0xc7, 0x98, 0x2a, 0x53, 0x64, 0x7a, 0x38,
+	0x7b, 0xbb, 0x16, 0xe7, 0xd8, 0xae, 0x97, 0x66, 0x6d, 0xd7, 0x5b, 0x3e, 0xc0, 0x20, 0x9f, 0xe3,
+	0xfa, 0xe0, 0x0b, 0xab, 0xc7, 0xba, 0x0f, 0x58, 0x77, 0xf0, 0xc8, 0x3a, 0xb8, 0xdf, 0xef, 0x75,
+	0xee, 0x74, 0x77, 0xba, 0x9d, 0xbb, 0x66, 0x81, 0xae, 0xc0, 0xf5, 0x3c, 0xb8, 0xf7, 0xe0, 0xa1,
+	0x49, 0xe8, 0xeb, 0x40, 0xf3, 0xc6, 0xfd, 0xce, 0xdd, 0xee, 0xc1, 0xbe, 0xb9, 0x40, 0x57, 0xc1,
+	0xcc, 0xdb, 0xef, 0x75, 0x77, 0xef, 0x99, 0xc6, 0x2d, 0x0b, 0xe0, 0x64, 0x2b, 0xea, 0x68, 0xfd,
+	0xee, 0xee, 0xfd, 0x0e, 0xb3, 0x06, 0x8f, 0x7a, 0x9d, 0x33, 0xd1, 0x36, 0x60, 0x2d, 0x0f, 0xf6,
+	0x3b, 0x77, 0x7a, 0xed, 0xf7, 0xde, 0xff, 0xac, 0x65, 0x92, 0x19, 0x10, 0x6b, 0x99, 0x0b, 0xed,
+	0xdf, 0x0c, 0x58, 0xde, 0xb5, 0x65, 0x27, 0x7b, 0x82, 0xe8, 0x77, 0x04, 0xcc, 0xb3, 0x17, 0x0f,
+	0xfd, 0xe0, 0xc2, 0x1e, 0xce, 0x78, 0x56, 0xca, 0x1f, 0xce, 0xc1, 0x4c, 0x0f, 0x53, 0xad, 0x40,
+	0x7f, 0x3a, 0xe7, 0x15, 0xc9, 0x2e, 0x41, 0xfa, 0xe9, 0xbf, 0xf6, 0x7c, 0xe6, 0x32, 0x2f, 0xdf,
+	0xfe, 0x0f, 0x1e, 0xa6, 0x39, 0xfe, 0x48, 0x60, 0xe5, 0x9c, 0x2b, 0x81, 0x7e, 0x7c, 0xa1, 0xf3,
+	0xd9, 0x97, 0x5e, 0xf9, 0x93, 0xf9, 0xc8, 0x59, 0x52, 0xdb, 0x83, 0x5f, 0x8f, 0x2a, 0xe4, 0xc5,
+	0x51, 0x85, 0xfc, 0x71, 0x54, 0x21, 0x3f, 0x1c, 0x57, 0x0a, 0x2f, 0x8e, 0x2b, 0x85, 0xdf, 0x8f,
+	0x2b, 0x85, 0x2f, 0x3f, 0xf2, 0xb8, 0x7a, 0x32, 0x72, 0x1a, 0xae, 0x08, 0x9a, 0x59, 0x0c, 0x11,
+	0x7b, 0xd3, 0xf1, 0x3b, 0x76, 0x14, 0x35, 0xf5, 0xe7, 0xc5, 0x91, 0xab, 0xff, 0x4e, 0x4e, 0x62,
+	0x3a, 0x97, 0x93, 0x9f, 0x88, 0xad, 0xbf, 0x07, 0x00, 0xf1, 0xe3, 0x78, 0x95, 0xd5, 0x08, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -335,6 +680,10 @@ type GasEstimatorClient interface {
 	// min gas price.
 	// It's up to the light client to set the gas price in this case
 	// to the minimum gas price set by that node.
+	// The estimate is raised if the transactions in the mempool would otherwise
+	// fill the squares of the blocks targeted by the priority. The response also
+	// contains the inclusion probability and expected wait of a transaction
+	// paying the estimated gas price given the current mempool.
 	EstimateGasPrice(ctx context.Context, in *EstimateGasPriceRequest, opts ...grpc.CallOption) (*EstimateGasPriceResponse, error)
 	// EstimateGasPriceAndUsage takes a transaction priority and a transaction bytes
 	// and estimates the gas price and the gas used for that transaction.
@@ -343,8 +692,16 @@ type GasEstimatorClient interface {
 	// min gas price.
 	// It's up to the light client to set the gas price in this case
 	// to the minimum gas price set by that node.
+	// As for EstimateGasPrice, the gas price estimate takes the mempool into
+	// account.
 	// The gas used is estimated using the state machine simulation.
 	EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error)
+	// QuoteBlobSubmission takes the sizes of a set of blobs and quotes the gas
+	// and fees of a transaction paying for them, without the transaction having
+	// to be built or signed.
+	// The gas is computed using the same model as the transaction client and the
+	// recommended fees are based on the gas price estimates of each priority.
+	QuoteBlobSubmission(ctx context.Context, in *QuoteBlobSubmissionRequest, opts ...grpc.CallOption) (*QuoteBlobSubmissionResponse, error)
 }
 
 type gasEstimatorClient struct {
@@ -373,6 +730,15 @@ func (c *gasEstimatorClient) EstimateGasPriceAndUsage(ctx context.Context, in *E
 	return out, nil
 }
 
+func (c *gasEstimatorClient) QuoteBlobSubmission(ctx context.Context, in *QuoteBlobSubmissionRequest, opts ...grpc.CallOption) (*QuoteBlobSubmissionResponse, error) {
+	out := new(QuoteBlobSubmissionResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/QuoteBlobSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GasEstimatorServer is the server API for GasEstimator service.
 type GasEstimatorServer interface {
 	// estimateGasPrice takes a transaction priority and estimates the gas price based
@@ -381,6 +747,10 @@ type GasEstimatorServer interface {
 	// min gas price.
 	// It's up to the light client to set the gas price in this case
 	// to the minimum gas price set by that node.
+	// The estimate is raised if the transactions in the mempool would otherwise
+	// fill the squares of the blocks targeted by the priority. The response also
+	// contains the inclusion probability and expected wait of a transaction
+	// paying the estimated gas price given the current mempool.
 	EstimateGasPrice(context.Context, *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error)
 	// EstimateGasPriceAndUsage takes a transaction priority and a transaction bytes
 	// and estimates the gas price and the gas used for that transaction.
@@ -389,8 +759,16 @@ type GasEstimatorServer interface {
 	// min gas price.
 	// It's up to the light client to set the gas price in this case
 	// to the minimum gas price set by that node.
+	// As for EstimateGasPrice, the gas price estimate takes the mempool into
+	// account.
 	// The gas used is estimated using the state machine simulation.
 	EstimateGasPriceAndUsage(context.Context, *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error)
+	// QuoteBlobSubmission takes the sizes of a set of blobs and quotes the gas
+	// and fees of a transaction paying for them, without the transaction having
+	// to be built or signed.
+	// The gas is computed using the same model as the transaction client and the
+	// recommended fees are based on the gas price estimates of each priority.
+	QuoteBlobSubmission(context.Context, *QuoteBlobSubmissionRequest) (*QuoteBlobSubmissionResponse, error)
 }
 
 // UnimplementedGasEstimatorServer can be embedded to have forward compatible implementations.
@@ -403,6 +781,9 @@ func (*UnimplementedGasEstimatorServer) EstimateGasPrice(ctx context.Context, re
 func (*UnimplementedGasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, req *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPriceAndUsage not implemented")
 }
+func (*UnimplementedGasEstimatorServer) QuoteBlobSubmission(ctx context.Context, req *QuoteBlobSubmissionRequest) (*QuoteBlobSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteBlobSubmission not implemented")
+}
 
 func RegisterGasEstimatorServer(s grpc1.Server, srv GasEstimatorServer) {
 	s.RegisterService(&_GasEstimator_serviceDesc, srv)
@@ -444,6 +825,24 @@ func _GasEstimator_EstimateGasPriceAndUsage_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GasEstimator_QuoteBlobSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteBlobSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).QuoteBlobSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/QuoteBlobSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).QuoteBlobSubmission(ctx, req.(*QuoteBlobSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GasEstimator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.core.v1.gas_estimation.GasEstimator",
 	HandlerType: (*GasEstimatorServer)(nil),
@@ -456,6 +855,10 @@ var _GasEstimator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGasPriceAndUsage",
 			Handler:    _GasEstimator_EstimateGasPriceAndUsage_Handler,
 		},
+		{
+			MethodName: "QuoteBlobSubmission",
+			Handler:    _GasEstimator_QuoteBlobSubmission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/core/v1/gas_estimation/gas_estimator.proto",
@@ -509,6 +912,17 @@ func (m *EstimateGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.ExpectedWaitBlocks != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.ExpectedWaitBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InclusionProbability != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.InclusionProbability))))
+		i--
+		dAtA[i] = 0x11
+	}
 	if m.EstimatedGasPrice != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
@@ -573,6 +987,17 @@ func (m *EstimateGasPriceAndUsageResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.ExpectedWaitBlocks != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.ExpectedWaitBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InclusionProbability != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.InclusionProbability))))
+		i--
+		dAtA[i] = 0x19
+	}
 	if m.EstimatedGasUsed != 0 {
 		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EstimatedGasUsed))
 		i--
@@ -587,53 +1012,235 @@ func (m *EstimateGasPriceAndUsageResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGasEstimator(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuoteBlobSubmissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EstimateGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TxPriority != 0 {
-		n += 1 + sovGasEstimator(uint64(m.TxPriority))
-	}
-	return n
+
+func (m *QuoteBlobSubmissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuoteBlobSubmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EstimatedGasPrice != 0 {
-		n += 9
+	if m.SignerType != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.SignerType))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintGasEstimator(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BlobSizes) > 0 {
+		dAtA2 := make([]byte, len(m.BlobSizes)*10)
+		var j1 int
+		for _, num := range m.BlobSizes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGasEstimator(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateGasPriceAndUsageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuoteBlobSubmissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuoteBlobSubmissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuoteBlobSubmissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TxPriority != 0 {
-		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	if len(m.RecommendedFees) > 0 {
+		for iNdEx := len(m.RecommendedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecommendedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovGasEstimator(uint64(l))
+	if m.MinFee != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.MinFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NetworkMinGasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NetworkMinGasPrice))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.BlobShares) > 0 {
+		dAtA4 := make([]byte, len(m.BlobShares)*10)
+		var j3 int
+		for _, num := range m.BlobShares {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGasEstimator(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobSubmissionFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobSubmissionFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobSubmissionFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpectedWaitBlocks != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.ExpectedWaitBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusionProbability != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.InclusionProbability))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Fee != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasPrice))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.TxPriority != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGasEstimator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EstimateGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	if m.InclusionProbability != 0 {
+		n += 9
+	}
+	if m.ExpectedWaitBlocks != 0 {
+		n += 1 + sovGasEstimator(uint64(m.ExpectedWaitBlocks))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceAndUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovGasEstimator(uint64(l))
 	}
 	return n
 }
@@ -650,6 +1257,92 @@ func (m *EstimateGasPriceAndUsageResponse) Size() (n int) {
 	if m.EstimatedGasUsed != 0 {
 		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
 	}
+	if m.InclusionProbability != 0 {
+		n += 9
+	}
+	if m.ExpectedWaitBlocks != 0 {
+		n += 1 + sovGasEstimator(uint64(m.ExpectedWaitBlocks))
+	}
+	return n
+}
+
+func (m *QuoteBlobSubmissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlobSizes) > 0 {
+		l = 0
+		for _, e := range m.BlobSizes {
+			l += sovGasEstimator(uint64(e))
+		}
+		n += 1 + sovGasEstimator(uint64(l)) + l
+	}
+	if len(m.Namespaces) > 0 {
+		for _, b := range m.Namespaces {
+			l = len(b)
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	if m.SignerType != 0 {
+		n += 1 + sovGasEstimator(uint64(m.SignerType))
+	}
+	return n
+}
+
+func (m *QuoteBlobSubmissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovGasEstimator(uint64(m.GasLimit))
+	}
+	if len(m.BlobShares) > 0 {
+		l = 0
+		for _, e := range m.BlobShares {
+			l += sovGasEstimator(uint64(e))
+		}
+		n += 1 + sovGasEstimator(uint64(l)) + l
+	}
+	if m.NetworkMinGasPrice != 0 {
+		n += 9
+	}
+	if m.MinFee != 0 {
+		n += 1 + sovGasEstimator(uint64(m.MinFee))
+	}
+	if len(m.RecommendedFees) > 0 {
+		for _, e := range m.RecommendedFees {
+			l = e.Size()
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlobSubmissionFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	if m.GasPrice != 0 {
+		n += 9
+	}
+	if m.Fee != 0 {
+		n += 1 + sovGasEstimator(uint64(m.Fee))
+	}
+	if m.InclusionProbability != 0 {
+		n += 9
+	}
+	if m.ExpectedWaitBlocks != 0 {
+		n += 1 + sovGasEstimator(uint64(m.ExpectedWaitBlocks))
+	}
 	return n
 }
 
@@ -768,6 +1461,36 @@ func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionProbability", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.InclusionProbability = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedWaitBlocks", wireType)
+			}
+			m.ExpectedWaitBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedWaitBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -951,6 +1674,551 @@ func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionProbability", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.InclusionProbability = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedWaitBlocks", wireType)
+			}
+			m.ExpectedWaitBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedWaitBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuoteBlobSubmissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuoteBlobSubmissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuoteBlobSubmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasEstimator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlobSizes = append(m.BlobSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasEstimator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasEstimator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasEstimator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlobSizes) == 0 {
+					m.BlobSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasEstimator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlobSizes = append(m.BlobSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
+			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerType", wireType)
+			}
+			m.SignerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerType |= SignerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuoteBlobSubmissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuoteBlobSubmissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuoteBlobSubmissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasEstimator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlobShares = append(m.BlobShares, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasEstimator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasEstimator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasEstimator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlobShares) == 0 {
+					m.BlobShares = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasEstimator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlobShares = append(m.BlobShares, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobShares", wireType)
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMinGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NetworkMinGasPrice = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			m.MinFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedFees = append(m.RecommendedFees, &BlobSubmissionFee{})
+			if err := m.RecommendedFees[len(m.RecommendedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobSubmissionFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobSubmissionFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobSubmissionFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			}
+			m.TxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPriority |= TxPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasPrice = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionProbability", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.InclusionProbability = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedWaitBlocks", wireType)
+			}
+			m.ExpectedWaitBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedWaitBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -0,0 +1,218 @@
+package gasestimation
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// clearingGasPriceBump is the relative amount by which the gas price of the
+// last mempool transaction that fits in the targeted blocks is outbid.
+const clearingGasPriceBump = 0.01
+
+// errNoFee is returned for transactions that don't pay a fee.
+var errNoFee = errors.New("tx doesn't pay a fee")
+
+// numberOfMempoolTransactions is the number of transactions with the highest
+// priority sampled from the mempool.
+// Note: the maximum number of transactions the endpoint returns is 100.
+var numberOfMempoolTransactions = 100
+
+// targetInclusionBlocks returns the number of blocks within which a
+// transaction of the given priority should be included.
+func targetInclusionBlocks(priority TxPriority) int {
+	switch priority {
+	case TxPriority_TX_PRIORITY_HIGH:
+		return 1
+	case TxPriority_TX_PRIORITY_LOW:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// mempoolTx is a transaction pending in the mempool.
+type mempoolTx struct {
+	gasPrice float64
+	// shares is the number of shares the transaction and its blobs occupy.
+	shares float64
+}
+
+// mempoolState is a snapshot of the transactions that compete for the next
+// squares.
+type mempoolState struct {
+	// txs are the sampled transactions ordered by decreasing gas price.
+	txs []mempoolTx
+	// unsampledShares are the shares occupied by the transactions that were
+	// not sampled. All of them have a gas price lower than or equal to the
+	// sampled transactions.
+	unsampledShares float64
+	// capacity is the number of shares available per square.
+	capacity float64
+}
+
+func newMempoolState(txs []mempoolTx, unsampledShares, capacity float64) mempoolState {
+	sort.SliceStable(txs, func(i, j int) bool { return txs[i].gasPrice > txs[j].gasPrice })
+	return mempoolState{txs: txs, unsampledShares: unsampledShares, capacity: capacity}
+}
+
+// clearingGasPrice returns the gas price a transaction occupying txShares
+// shares must pay to be ahead of all the pending transactions that don't fit
+// in the given number of blocks. It returns zero if all the pending
+// transactions fit.
+func (m mempoolState) clearingGasPrice(blocks int, txShares float64) float64 {
+	available := float64(blocks)*m.capacity - txShares
+	cumulative := 0.0
+	for _, tx := range m.txs {
+		cumulative += tx.shares
+		if cumulative > available {
+			return tx.gasPrice * (1 + clearingGasPriceBump)
+		}
+	}
+	if len(m.txs) > 0 && cumulative+m.unsampledShares > available {
+		// the unsampled transactions don't pay more than the last sampled one
+		return m.txs[len(m.txs)-1].gasPrice * (1 + clearingGasPriceBump)
+	}
+	return 0
+}
+
+// inclusion returns the probability that a transaction occupying txShares
+// shares and paying the gas price is included in the next block along with
+// the number of blocks it is expected to wait. Transactions paying a higher
+// gas price are included first and transactions paying the same gas price
+// are assumed to be included in a random order.
+func (m mempoolState) inclusion(gasPrice, txShares float64) (float64, uint64) {
+	if m.capacity <= 0 {
+		return 0, 0
+	}
+
+	var ahead, tied float64
+	for _, tx := range m.txs {
+		switch {
+		case tx.gasPrice > gasPrice:
+			ahead += tx.shares
+		case tx.gasPrice == gasPrice:
+			tied += tx.shares
+		}
+	}
+	if len(m.txs) > 0 && gasPrice < m.txs[len(m.txs)-1].gasPrice {
+		// the order of the unsampled transactions is unknown
+		tied += m.unsampledShares
+	}
+
+	waitBlocks := uint64(math.Floor(ahead/m.capacity)) + 1
+	remaining := m.capacity - ahead - txShares
+	switch {
+	case remaining < 0:
+		return 0, waitBlocks
+	case remaining >= tied:
+		return 1, waitBlocks
+	default:
+		return remaining / tied, waitBlocks
+	}
+}
+
+// mempoolState samples the transactions with the highest priority from the
+// mempool of the node.
+func (s *gasEstimatorServer) mempoolState(ctx context.Context) (mempoolState, error) {
+	squareSize := s.maxEffectiveSquareSize(ctx)
+	capacity := float64(squareSize * squareSize)
+
+	unconfirmed, err := s.clientCtx.Client.UnconfirmedTxs(ctx, &numberOfMempoolTransactions)
+	if err != nil {
+		return mempoolState{}, err
+	}
+
+	// estimate the shares of the transactions that were not sampled from
+	// their size
+	unsampledShares := 0.0
+	if unconfirmed.Total > unconfirmed.Count {
+		unsampledBytes := unconfirmed.TotalBytes
+		for _, tx := range unconfirmed.Txs {
+			unsampledBytes -= int64(len(tx))
+		}
+		if unsampledBytes > 0 {
+			unsampledShares = float64(unsampledBytes) / share.ContinuationSparseShareContentSize
+		}
+	}
+	return newMempoolState(s.parseMempoolTxs(unconfirmed), unsampledShares, capacity), nil
+}
+
+// parseMempoolTxs returns the gas price and shares of the transactions. The
+// transactions that can't be decoded or don't pay a fee are ignored.
+func (s *gasEstimatorServer) parseMempoolTxs(unconfirmed *coretypes.ResultUnconfirmedTxs) []mempoolTx {
+	decoder := s.clientCtx.TxConfig.TxDecoder()
+	txs := make([]mempoolTx, 0, len(unconfirmed.Txs))
+	for _, rawTx := range unconfirmed.Txs {
+		gasPrice, shares, err := txGasPriceAndShares(decoder, rawTx)
+		if err != nil {
+			continue
+		}
+		txs = append(txs, mempoolTx{gasPrice: gasPrice, shares: shares})
+	}
+	return txs
+}
+
+// txGasPriceAndShares decodes a transaction, which may be a BlobTx, and
+// returns its gas price and the number of shares it occupies.
+func txGasPriceAndShares(decoder sdk.TxDecoder, rawTx []byte) (float64, float64, error) {
+	txBytes, shares, err := txShares(rawTx)
+	if err != nil {
+		return 0, 0, err
+	}
+	tx, err := decoder(txBytes)
+	if err != nil {
+		return 0, 0, err
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return 0, 0, errNoFee
+	}
+	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
+	return float64(fee.Uint64()) / float64(feeTx.GetGas()), shares, nil
+}
+
+// txShares returns the number of shares occupied by a transaction, which may
+// be a BlobTx, along with the bytes of the sdk transaction.
+func txShares(rawTx []byte) ([]byte, float64, error) {
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+	if isBlob && err != nil {
+		return nil, 0, err
+	}
+	if !isBlob {
+		return rawTx, float64(len(rawTx)) / share.ContinuationCompactShareContentSize, nil
+	}
+	shares := float64(len(bTx.Tx)) / share.ContinuationCompactShareContentSize
+	for _, blob := range bTx.Blobs {
+		shares += float64(share.SparseSharesNeeded(uint32(len(blob.Data()))))
+	}
+	return bTx.Tx, shares, nil
+}
+
+// maxEffectiveSquareSize returns the max effective square size at the state
+// the query is made against. The default governance max square size is
+// returned if the state isn't available.
+func (s *gasEstimatorServer) maxEffectiveSquareSize(ctx context.Context) int {
+	if s.maxSquareSizeFn == nil {
+		return appconsts.DefaultGovMaxSquareSize
+	}
+	sdkCtx, ok := sdkContext(ctx)
+	if !ok {
+		return appconsts.DefaultGovMaxSquareSize
+	}
+	return s.maxSquareSizeFn(sdkCtx)
+}
+
+// sdkContext returns the sdk context of the state the query is made against,
+// if any.
+func sdkContext(ctx context.Context) (sdk.Context, bool) {
+	sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	return sdkCtx, ok
+}
@@ -0,0 +1,125 @@
+package gasestimation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClearingGasPrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		mempool mempoolState
+		blocks  int
+		want    float64
+	}{
+		{
+			name:    "empty mempool",
+			mempool: newMempoolState(nil, 0, 10),
+			blocks:  1,
+			want:    0,
+		},
+		{
+			name:    "mempool fits in the next block",
+			mempool: newMempoolState([]mempoolTx{{gasPrice: 2, shares: 4}, {gasPrice: 1, shares: 4}}, 0, 10),
+			blocks:  1,
+			want:    0,
+		},
+		{
+			name:    "mempool overflows the next block",
+			mempool: newMempoolState([]mempoolTx{{gasPrice: 1, shares: 4}, {gasPrice: 3, shares: 4}, {gasPrice: 2, shares: 4}}, 0, 10),
+			blocks:  1,
+			want:    1 * (1 + clearingGasPriceBump),
+		},
+		{
+			name:    "mempool fits in the targeted blocks",
+			mempool: newMempoolState([]mempoolTx{{gasPrice: 1, shares: 4}, {gasPrice: 3, shares: 4}, {gasPrice: 2, shares: 4}}, 0, 10),
+			blocks:  2,
+			want:    0,
+		},
+		{
+			name:    "unsampled transactions overflow the next block",
+			mempool: newMempoolState([]mempoolTx{{gasPrice: 3, shares: 4}, {gasPrice: 2, shares: 4}}, 10, 10),
+			blocks:  1,
+			want:    2 * (1 + clearingGasPriceBump),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.mempool.clearingGasPrice(tt.blocks, 1), 1e-9)
+		})
+	}
+}
+
+func TestInclusion(t *testing.T) {
+	mempool := newMempoolState([]mempoolTx{
+		{gasPrice: 4, shares: 8},
+		{gasPrice: 3, shares: 8},
+		{gasPrice: 2, shares: 4},
+		{gasPrice: 2, shares: 4},
+		{gasPrice: 1, shares: 8},
+	}, 16, 10)
+
+	tests := []struct {
+		name            string
+		gasPrice        float64
+		wantProbability float64
+		wantWaitBlocks  uint64
+	}{
+		{
+			name:            "ahead of the mempool",
+			gasPrice:        5,
+			wantProbability: 1,
+			wantWaitBlocks:  1,
+		},
+		{
+			name:            "tied with the highest transaction",
+			gasPrice:        4,
+			wantProbability: 1,
+			wantWaitBlocks:  1,
+		},
+		{
+			name:            "behind a full square",
+			gasPrice:        2.5,
+			wantProbability: 0,
+			wantWaitBlocks:  2,
+		},
+		{
+			name:            "behind two full squares",
+			gasPrice:        0.5,
+			wantProbability: 0,
+			wantWaitBlocks:  4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probability, waitBlocks := mempool.inclusion(tt.gasPrice, 1)
+			assert.Equal(t, tt.wantProbability, probability)
+			assert.Equal(t, tt.wantWaitBlocks, waitBlocks)
+		})
+	}
+
+	t.Run("tied transactions share the remaining capacity", func(t *testing.T) {
+		mempool := newMempoolState([]mempoolTx{{gasPrice: 2, shares: 5}, {gasPrice: 1, shares: 4}, {gasPrice: 1, shares: 4}}, 0, 10)
+		probability, waitBlocks := mempool.inclusion(1, 1)
+		assert.Equal(t, 0.5, probability)
+		assert.Equal(t, uint64(1), waitBlocks)
+	})
+
+	t.Run("unknown mempool", func(t *testing.T) {
+		var mempool mempoolState
+		assert.Zero(t, mempool.clearingGasPrice(1, 1))
+		probability, waitBlocks := mempool.inclusion(1, 1)
+		assert.Zero(t, probability)
+		assert.Zero(t, waitBlocks)
+	})
+}
+
+func TestTargetInclusionBlocks(t *testing.T) {
+	assert.Equal(t, 1, targetInclusionBlocks(TxPriority_TX_PRIORITY_HIGH))
+	assert.Equal(t, 2, targetInclusionBlocks(TxPriority_TX_PRIORITY_MEDIUM))
+	assert.Equal(t, 2, targetInclusionBlocks(TxPriority_TX_PRIORITY_UNSPECIFIED))
+	assert.Equal(t, 3, targetInclusionBlocks(TxPriority_TX_PRIORITY_LOW))
+}
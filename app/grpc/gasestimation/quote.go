@@ -0,0 +1,142 @@
+package gasestimation
+
+import (
+	"context"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// quotedPriorities are the priorities a fee is recommended for in a quote.
+var quotedPriorities = []TxPriority{
+	TxPriority_TX_PRIORITY_LOW,
+	TxPriority_TX_PRIORITY_MEDIUM,
+	TxPriority_TX_PRIORITY_HIGH,
+}
+
+// gasMultiplier is applied to the estimated gas of a blob submission because
+// the estimate is sometimes lower than the gas used. It matches the default
+// gas multiplier of the user package's TxClient.
+const gasMultiplier = 1.1
+
+// QuoteBlobSubmission takes the sizes of a set of blobs and quotes the gas and
+// fees of a transaction paying for them.
+func (s *gasEstimatorServer) QuoteBlobSubmission(ctx context.Context, request *QuoteBlobSubmissionRequest) (*QuoteBlobSubmissionResponse, error) {
+	if err := validateQuoteRequest(request); err != nil {
+		return nil, err
+	}
+
+	gasLimit := uint64(float64(estimateBlobSubmissionGas(s.appVersion(ctx), request.BlobSizes, request.SignerType)) * gasMultiplier)
+	blobShares := make([]uint32, len(request.BlobSizes))
+	txShares := float64(defaultTxShares)
+	for i, size := range request.BlobSizes {
+		blobShares[i] = uint32(share.SparseSharesNeeded(size))
+		txShares += float64(blobShares[i])
+	}
+
+	networkMinGasPrice, err := s.networkMinGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	estimates, err := s.estimateGasPrices(ctx, quotedPriorities, txShares)
+	if err != nil {
+		return nil, err
+	}
+	recommendedFees := make([]*BlobSubmissionFee, len(quotedPriorities))
+	for i, estimate := range estimates {
+		recommendedFees[i] = &BlobSubmissionFee{
+			TxPriority:           quotedPriorities[i],
+			GasPrice:             estimate.gasPrice,
+			Fee:                  uint64(math.Ceil(estimate.gasPrice * float64(gasLimit))),
+			InclusionProbability: estimate.inclusionProbability,
+			ExpectedWaitBlocks:   estimate.expectedWaitBlocks,
+		}
+	}
+
+	return &QuoteBlobSubmissionResponse{
+		GasLimit:           gasLimit,
+		BlobShares:         blobShares,
+		NetworkMinGasPrice: networkMinGasPrice.MustFloat64(),
+		// the fee is rounded up the same way the network min gas price is
+		// enforced
+		MinFee:          networkMinGasPrice.MulInt(sdk.NewIntFromUint64(gasLimit)).Ceil().TruncateInt().Uint64(),
+		RecommendedFees: recommendedFees,
+	}, nil
+}
+
+func validateQuoteRequest(request *QuoteBlobSubmissionRequest) error {
+	if request == nil {
+		return status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if len(request.BlobSizes) == 0 {
+		return status.Error(codes.InvalidArgument, "blob sizes cannot be empty")
+	}
+	for i, size := range request.BlobSizes {
+		if size == 0 {
+			return status.Errorf(codes.InvalidArgument, "blob %d: %s", i, blobtypes.ErrZeroBlobSize)
+		}
+	}
+	if len(request.Namespaces) == 0 {
+		return nil
+	}
+	if len(request.Namespaces) != len(request.BlobSizes) {
+		return status.Errorf(codes.InvalidArgument, "expected %d namespaces, got %d", len(request.BlobSizes), len(request.Namespaces))
+	}
+	for i, nsBytes := range request.Namespaces {
+		ns, err := share.NewNamespaceFromBytes(nsBytes)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "blob %d: invalid namespace: %s", i, err)
+		}
+		if err := blobtypes.ValidateBlobNamespace(ns); err != nil {
+			return status.Errorf(codes.InvalidArgument, "blob %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// estimateBlobSubmissionGas estimates the gas of a transaction paying for
+// blobs of the given sizes. The gas model assumes a secp256k1 signer so the
+// signature verification cost is adjusted for the other signer types.
+func estimateBlobSubmissionGas(appVersion uint64, blobSizes []uint32, signerType SignerType) uint64 {
+	gas := blobtypes.EstimateGas(blobSizes, appconsts.GasPerBlobByte(appVersion), appconsts.TxSizeCostPerByte(appVersion))
+	if signerType == SignerType_SIGNER_TYPE_SECP256R1 {
+		params := authtypes.DefaultParams()
+		gas = gas - params.SigVerifyCostSecp256k1 + params.SigVerifyCostSecp256r1()
+	}
+	return gas
+}
+
+// networkMinGasPrice returns the network min gas price at the state the query
+// is made against. The default network min gas price is returned if the state
+// isn't available.
+func (s *gasEstimatorServer) networkMinGasPrice(ctx context.Context) (sdk.Dec, error) {
+	if s.minFeeServer == nil {
+		return minfee.DefaultNetworkMinGasPrice, nil
+	}
+	if _, ok := sdkContext(ctx); !ok {
+		return minfee.DefaultNetworkMinGasPrice, nil
+	}
+	resp, err := s.minFeeServer.NetworkMinGasPrice(ctx, &minfee.QueryNetworkMinGasPrice{})
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return resp.NetworkMinGasPrice, nil
+}
+
+// appVersion returns the app version of the state the query is made against.
+// The latest version is returned if the state isn't available.
+func (s *gasEstimatorServer) appVersion(ctx context.Context) uint64 {
+	sdkCtx, ok := sdkContext(ctx)
+	if !ok || sdkCtx.BlockHeader().Version.App == 0 {
+		return appconsts.LatestVersion
+	}
+	return sdkCtx.BlockHeader().Version.App
+}
@@ -0,0 +1,98 @@
+package gasestimation
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateQuoteRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *QuoteBlobSubmissionRequest
+		wantErr bool
+	}{
+		{
+			name:    "nil request",
+			request: nil,
+			wantErr: true,
+		},
+		{
+			name:    "no blobs",
+			request: &QuoteBlobSubmissionRequest{},
+			wantErr: true,
+		},
+		{
+			name:    "zero blob size",
+			request: &QuoteBlobSubmissionRequest{BlobSizes: []uint32{100, 0}},
+			wantErr: true,
+		},
+		{
+			name:    "blob sizes without namespaces",
+			request: &QuoteBlobSubmissionRequest{BlobSizes: []uint32{100, 200}},
+			wantErr: false,
+		},
+		{
+			name: "blob sizes with namespaces",
+			request: &QuoteBlobSubmissionRequest{
+				BlobSizes:  []uint32{100, 200},
+				Namespaces: [][]byte{share.RandomBlobNamespace().Bytes(), share.RandomBlobNamespace().Bytes()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing namespace",
+			request: &QuoteBlobSubmissionRequest{
+				BlobSizes:  []uint32{100, 200},
+				Namespaces: [][]byte{share.RandomBlobNamespace().Bytes()},
+			},
+			wantErr: true,
+		},
+		{
+			name: "reserved namespace",
+			request: &QuoteBlobSubmissionRequest{
+				BlobSizes:  []uint32{100},
+				Namespaces: [][]byte{share.TxNamespace.Bytes()},
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed namespace",
+			request: &QuoteBlobSubmissionRequest{
+				BlobSizes:  []uint32{100},
+				Namespaces: [][]byte{{1, 2, 3}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateQuoteRequest(tt.request)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestEstimateBlobSubmissionGas(t *testing.T) {
+	blobSizes := []uint32{100, share.ContinuationSparseShareContentSize * 10}
+	secp256k1Gas := blobtypes.DefaultEstimateGas(blobSizes)
+
+	assert.Equal(t, secp256k1Gas, estimateBlobSubmissionGas(appconsts.LatestVersion, blobSizes, SignerType_SIGNER_TYPE_UNSPECIFIED))
+	assert.Equal(t, secp256k1Gas, estimateBlobSubmissionGas(appconsts.LatestVersion, blobSizes, SignerType_SIGNER_TYPE_SECP256K1))
+
+	params := authtypes.DefaultParams()
+	assert.Equal(t, secp256k1Gas-params.SigVerifyCostSecp256k1+params.SigVerifyCostSecp256r1(), estimateBlobSubmissionGas(appconsts.LatestVersion, blobSizes, SignerType_SIGNER_TYPE_SECP256R1))
+}
@@ -3,24 +3,44 @@ package tx
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"io"
+	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/tendermint/tendermint/rpc/core"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 )
 
+// MaxTxStatusBatchSize is the maximum number of transactions that can be
+// queried by a single TxStatusBatch request.
+const MaxTxStatusBatchSize = 1000
+
+const (
+	// subscribeTxStatusInterval is the interval at which SubscribeTxStatus
+	// checks the status of the watched transactions.
+	subscribeTxStatusInterval = 500 * time.Millisecond
+	// maxSubscribedTxs is the maximum number of transactions that can be
+	// watched by all the SubscribeTxStatus streams of a server at once.
+	maxSubscribedTxs = 10_000
+)
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	squareLayoutFn squareLayoutFn,
 ) {
 	RegisterTxServer(
 		qrt,
-		NewTxServer(clientCtx, interfaceRegistry),
+		NewTxServer(clientCtx, interfaceRegistry, squareLayoutFn),
 	)
 }
 
@@ -38,12 +58,19 @@ var _ TxServer = &txServer{}
 type txServer struct {
 	clientCtx         client.Context
 	interfaceRegistry codectypes.InterfaceRegistry
+	squareLayoutFn    squareLayoutFn
+
+	mtx sync.Mutex
+	// subscribedTxs is the number of transactions watched by all the
+	// SubscribeTxStatus streams.
+	subscribedTxs int
 }
 
-func NewTxServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry) TxServer {
+func NewTxServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry, squareLayoutFn squareLayoutFn) TxServer {
 	return &txServer{
 		clientCtx:         clientCtx,
 		interfaceRegistry: interfaceRegistry,
+		squareLayoutFn:    squareLayoutFn,
 	}
 }
 
@@ -72,11 +99,214 @@ func (s *txServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatu
 		return nil, err
 	}
 
+	return newTxStatusResponse(resTx), nil
+}
+
+// TxStatusBatch implements the TxServer.TxStatusBatch method querying the
+// status of every transaction from the underlying celestia-core RPC server.
+func (s *txServer) TxStatusBatch(ctx context.Context, req *TxStatusBatchRequest) (*TxStatusBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.TxIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx ids cannot be empty")
+	}
+
+	if len(req.TxIds) > MaxTxStatusBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot query more than %d txs, got %d", MaxTxStatusBatchSize, len(req.TxIds))
+	}
+
+	txIDs := make([][]byte, len(req.TxIds))
+	for i, id := range req.TxIds {
+		txID, err := hex.DecodeString(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tx id %s: %s", id, err)
+		}
+		txIDs[i] = txID
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*TxStatusResult, len(txIDs))
+	for i, txID := range txIDs {
+		resTx, err := node.TxStatus(ctx, txID)
+		if err != nil {
+			return nil, err
+		}
+		statuses[i] = &TxStatusResult{
+			TxId:   req.TxIds[i],
+			Status: newTxStatusResponse(resTx),
+		}
+	}
+
+	return &TxStatusBatchResponse{Statuses: statuses}, nil
+}
+
+// SubscribeTxStatus implements the TxServer.SubscribeTxStatus method. It
+// periodically checks the status of the watched transactions in batches with
+// the underlying celestia-core RPC server and streams the ones that changed.
+// Transactions are added to the stream by every request the client sends.
+func (s *txServer) SubscribeTxStatus(stream Tx_SubscribeTxStatusServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// the requests are received concurrently so that the watched transactions
+	// keep being checked while the client doesn't send any.
+	requests := make(chan *SubscribeTxStatusRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// watched maps the tx ids that haven't reached a final status to the last
+	// status that was sent for them.
+	watched := make(map[string]string)
+	defer func() {
+		s.releaseSubscribedTxs(len(watched))
+	}()
+
+	ticker := time.NewTicker(subscribeTxStatusInterval)
+	defer ticker.Stop()
+
+	closed := false
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-recvErr:
+			if !errors.Is(err, io.EOF) {
+				return err
+			}
+			// the client doesn't watch any more transactions
+			closed = true
+			recvErr = nil
+		case req := <-requests:
+			txIDs, err := s.watchTxs(watched, req)
+			if err != nil {
+				return err
+			}
+			// the current status of the new transactions is sent right away
+			if err := s.sendTxStatuses(ctx, stream, watched, txIDs); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			txIDs := make([]string, 0, len(watched))
+			for id := range watched {
+				txIDs = append(txIDs, id)
+			}
+			if err := s.sendTxStatuses(ctx, stream, watched, txIDs); err != nil {
+				return err
+			}
+		}
+
+		if closed && len(watched) == 0 {
+			return nil
+		}
+	}
+}
+
+// watchTxs adds the transactions of the request to the watched ones and
+// returns their ids. Transactions that are already watched have their current
+// status sent again.
+func (s *txServer) watchTxs(watched map[string]string, req *SubscribeTxStatusRequest) ([]string, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.TxIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx ids cannot be empty")
+	}
+
+	added := make(map[string]struct{}, len(req.TxIds))
+	for _, id := range req.TxIds {
+		if _, err := hex.DecodeString(id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tx id %s: %s", id, err)
+		}
+		if _, ok := watched[id]; !ok {
+			added[id] = struct{}{}
+		}
+	}
+
+	if !s.reserveSubscribedTxs(len(added)) {
+		return nil, status.Errorf(codes.ResourceExhausted, "cannot watch more than %d txs", maxSubscribedTxs)
+	}
+	for _, id := range req.TxIds {
+		watched[id] = ""
+	}
+	return req.TxIds, nil
+}
+
+// sendTxStatuses checks the status of the transactions in batches and sends
+// the ones that changed. Transactions that reached a final status are no
+// longer watched.
+func (s *txServer) sendTxStatuses(ctx context.Context, stream Tx_SubscribeTxStatusServer, watched map[string]string, txIDs []string) error {
+	for start := 0; start < len(txIDs); start += MaxTxStatusBatchSize {
+		end := min(start+MaxTxStatusBatchSize, len(txIDs))
+		resp, err := s.TxStatusBatch(ctx, &TxStatusBatchRequest{TxIds: txIDs[start:end]})
+		if err != nil {
+			return err
+		}
+
+		for _, result := range resp.Statuses {
+			lastStatus, ok := watched[result.TxId]
+			if !ok || result.Status.Status == lastStatus {
+				continue
+			}
+
+			if err := stream.Send(&TxStatusEvent{TxId: result.TxId, Status: result.Status}); err != nil {
+				return err
+			}
+
+			if result.Status.Status == core.TxStatusPending {
+				watched[result.TxId] = result.Status.Status
+			} else {
+				delete(watched, result.TxId)
+				s.releaseSubscribedTxs(1)
+			}
+		}
+	}
+	return nil
+}
+
+// reserveSubscribedTxs reserves n of the transactions that can be watched by
+// all streams and reports whether there were enough of them left.
+func (s *txServer) reserveSubscribedTxs(n int) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.subscribedTxs+n > maxSubscribedTxs {
+		return false
+	}
+	s.subscribedTxs += n
+	return true
+}
+
+func (s *txServer) releaseSubscribedTxs(n int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.subscribedTxs -= n
+}
+
+func newTxStatusResponse(resTx *coretypes.ResultTxStatus) *TxStatusResponse {
 	return &TxStatusResponse{
 		Height:        resTx.Height,
 		Index:         resTx.Index,
 		ExecutionCode: resTx.ExecutionCode,
 		Error:         resTx.Error,
 		Status:        resTx.Status,
-	}, nil
+	}
 }
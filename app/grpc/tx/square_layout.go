@@ -0,0 +1,164 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	coretypes "github.com/tendermint/tendermint/types"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// squareLayoutMempoolTxs is the number of transactions with the highest
+// priority the square is dry run with.
+// Note: the maximum number of transactions the endpoint returns is 100.
+var squareLayoutMempoolTxs = 100
+
+// SquareLayout is the layout of a square built from a set of transactions.
+type SquareLayout struct {
+	// Txs are the transactions included in the square in square order.
+	Txs [][]byte
+	// BlobStartIndexes are the indexes of the first share of the first blob of
+	// each transaction in Txs, or -1 for transactions without blobs.
+	BlobStartIndexes []int
+	// SquareSize is the size of the square.
+	SquareSize uint64
+	// MaxSquareSize is the max effective square size the square is built
+	// under.
+	MaxSquareSize uint64
+}
+
+// squareLayoutFn is the signature of the App#SquareLayout function.
+type squareLayoutFn func(ctx sdk.Context, txs [][]byte) (SquareLayout, error)
+
+// DryRunSquareLayout implements the TxServer.DryRunSquareLayout method. It
+// builds the square from the transactions with the highest priority in the
+// mempool and the BlobTx, which is prioritised by its gas price after the
+// mempool transactions paying the same gas price.
+func (s *txServer) DryRunSquareLayout(ctx context.Context, req *DryRunSquareLayoutRequest) (*DryRunSquareLayoutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(req.BlobTx)
+	if !isBlob {
+		return nil, status.Error(codes.InvalidArgument, "tx is not a blob tx")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blob tx: %s", err)
+	}
+	decoder := s.clientCtx.TxConfig.TxDecoder()
+	gasPrice, err := txGasPrice(decoder, bTx.Tx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blob tx: %s", err)
+	}
+
+	sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	if s.squareLayoutFn == nil || !ok {
+		return nil, status.Error(codes.Unavailable, "square layout is not available")
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	unconfirmed, err := node.UnconfirmedTxs(ctx, &squareLayoutMempoolTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	// the mempool transactions are ordered by decreasing priority
+	txHash := string(coretypes.Tx(req.BlobTx).Hash())
+	mempoolTxs := make([][]byte, 0, len(unconfirmed.Txs))
+	position := -1
+	for _, rawTx := range unconfirmed.Txs {
+		if string(rawTx.Hash()) == txHash {
+			continue
+		}
+		if position == -1 {
+			if price, err := txGasPrice(decoder, rawTx); err == nil && price < gasPrice {
+				position = len(mempoolTxs)
+			}
+		}
+		mempoolTxs = append(mempoolTxs, rawTx)
+	}
+	if position == -1 {
+		position = len(mempoolTxs)
+	}
+	txs := make([][]byte, 0, len(mempoolTxs)+1)
+	txs = append(txs, mempoolTxs[:position]...)
+	txs = append(txs, req.BlobTx)
+	txs = append(txs, mempoolTxs[position:]...)
+
+	withTx, err := s.squareLayoutFn(sdkCtx, txs)
+	if err != nil {
+		return nil, err
+	}
+	// transactions can only be displaced if the square doesn't include all of
+	// them, so the square without the BlobTx is only built in that case.
+	displaced := []string{}
+	if len(withTx.Txs) < len(txs) {
+		withoutTx, err := s.squareLayoutFn(sdkCtx, mempoolTxs)
+		if err != nil {
+			return nil, err
+		}
+		displaced = displacedTxIDs(withoutTx.Txs, withTx.Txs)
+	}
+
+	resp := &DryRunSquareLayoutResponse{
+		SquareSize:     withTx.SquareSize,
+		MaxSquareSize:  withTx.MaxSquareSize,
+		DisplacedTxIds: displaced,
+		MempoolTxs:     uint64(len(mempoolTxs)),
+	}
+	for i, squareTx := range withTx.Txs {
+		if string(coretypes.Tx(squareTx).Hash()) != txHash {
+			continue
+		}
+		resp.Fits = true
+		resp.StartShareIndex = uint32(withTx.BlobStartIndexes[i])
+		break
+	}
+	return resp, nil
+}
+
+// displacedTxIDs returns the hex encoded hashes of the transactions included
+// in the square before that aren't included in the square after.
+func displacedTxIDs(before, after [][]byte) []string {
+	included := make(map[string]struct{}, len(after))
+	for _, rawTx := range after {
+		included[string(coretypes.Tx(rawTx).Hash())] = struct{}{}
+	}
+	displaced := make([]string, 0)
+	for _, rawTx := range before {
+		hash := coretypes.Tx(rawTx).Hash()
+		if _, ok := included[string(hash)]; !ok {
+			displaced = append(displaced, fmt.Sprintf("%X", hash))
+		}
+	}
+	return displaced
+}
+
+// txGasPrice decodes an sdk transaction, which may be wrapped in a BlobTx, and
+// returns its gas price.
+func txGasPrice(decoder sdk.TxDecoder, rawTx []byte) (float64, error) {
+	if bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx); isBlob {
+		if err != nil {
+			return 0, err
+		}
+		rawTx = bTx.Tx
+	}
+	tx, err := decoder(rawTx)
+	if err != nil {
+		return 0, err
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return 0, errors.New("tx doesn't pay a fee")
+	}
+	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
+	return float64(fee.Uint64()) / float64(feeTx.GetGas()), nil
+}
@@ -156,37 +156,460 @@ func (m *TxStatusResponse) GetStatus() string {
 	return ""
 }
 
+// TxStatusBatchRequest is the request type for the TxStatusBatch gRPC method.
+type TxStatusBatchRequest struct {
+	// tx_ids are the hex encoded hashes of the transactions.
+	TxIds []string `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (m *TxStatusBatchRequest) Reset()         { *m = TxStatusBatchRequest{} }
+func (m *TxStatusBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*TxStatusBatchRequest) ProtoMessage()    {}
+func (*TxStatusBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{2}
+}
+func (m *TxStatusBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusBatchRequest.Merge(m, src)
+}
+func (m *TxStatusBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusBatchRequest proto.InternalMessageInfo
+
+func (m *TxStatusBatchRequest) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
+}
+
+// TxStatusBatchResponse is the response type for the TxStatusBatch gRPC
+// method.
+type TxStatusBatchResponse struct {
+	Statuses []*TxStatusResult `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (m *TxStatusBatchResponse) Reset()         { *m = TxStatusBatchResponse{} }
+func (m *TxStatusBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*TxStatusBatchResponse) ProtoMessage()    {}
+func (*TxStatusBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{3}
+}
+func (m *TxStatusBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusBatchResponse.Merge(m, src)
+}
+func (m *TxStatusBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusBatchResponse proto.InternalMessageInfo
+
+func (m *TxStatusBatchResponse) GetStatuses() []*TxStatusResult {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// TxStatusResult is the status of a single transaction of a TxStatusBatch
+// request.
+type TxStatusResult struct {
+	// tx_id is the hex encoded hash of the transaction, as provided in the
+	// request.
+	TxId   string            `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Status *TxStatusResponse `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *TxStatusResult) Reset()         { *m = TxStatusResult{} }
+func (m *TxStatusResult) String() string { return proto.CompactTextString(m) }
+func (*TxStatusResult) ProtoMessage()    {}
+func (*TxStatusResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{4}
+}
+func (m *TxStatusResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusResult.Merge(m, src)
+}
+func (m *TxStatusResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusResult proto.InternalMessageInfo
+
+func (m *TxStatusResult) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *TxStatusResult) GetStatus() *TxStatusResponse {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+// SubscribeTxStatusRequest is the request type for the SubscribeTxStatus gRPC
+// method.
+type SubscribeTxStatusRequest struct {
+	// tx_ids are the hex encoded hashes of the transactions to start watching.
+	TxIds []string `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (m *SubscribeTxStatusRequest) Reset()         { *m = SubscribeTxStatusRequest{} }
+func (m *SubscribeTxStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTxStatusRequest) ProtoMessage()    {}
+func (*SubscribeTxStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{5}
+}
+func (m *SubscribeTxStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeTxStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeTxStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeTxStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTxStatusRequest.Merge(m, src)
+}
+func (m *SubscribeTxStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeTxStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTxStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTxStatusRequest proto.InternalMessageInfo
+
+func (m *SubscribeTxStatusRequest) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
+}
+
+// TxStatusEvent is a status transition of a transaction streamed by
+// SubscribeTxStatus.
+type TxStatusEvent struct {
+	// tx_id is the hex encoded hash of the transaction, as provided in the
+	// request.
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// status is the new status of the transaction.
+	Status *TxStatusResponse `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *TxStatusEvent) Reset()         { *m = TxStatusEvent{} }
+func (m *TxStatusEvent) String() string { return proto.CompactTextString(m) }
+func (*TxStatusEvent) ProtoMessage()    {}
+func (*TxStatusEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{6}
+}
+func (m *TxStatusEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusEvent.Merge(m, src)
+}
+func (m *TxStatusEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusEvent proto.InternalMessageInfo
+
+func (m *TxStatusEvent) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *TxStatusEvent) GetStatus() *TxStatusResponse {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+// DryRunSquareLayoutRequest is the request type for the DryRunSquareLayout
+// gRPC method.
+type DryRunSquareLayoutRequest struct {
+	// blob_tx is the encoded BlobTx to lay out in the square.
+	BlobTx []byte `protobuf:"bytes,1,opt,name=blob_tx,json=blobTx,proto3" json:"blob_tx,omitempty"`
+}
+
+func (m *DryRunSquareLayoutRequest) Reset()         { *m = DryRunSquareLayoutRequest{} }
+func (m *DryRunSquareLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunSquareLayoutRequest) ProtoMessage()    {}
+func (*DryRunSquareLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{7}
+}
+func (m *DryRunSquareLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunSquareLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunSquareLayoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunSquareLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunSquareLayoutRequest.Merge(m, src)
+}
+func (m *DryRunSquareLayoutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunSquareLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunSquareLayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunSquareLayoutRequest proto.InternalMessageInfo
+
+func (m *DryRunSquareLayoutRequest) GetBlobTx() []byte {
+	if m != nil {
+		return m.BlobTx
+	}
+	return nil
+}
+
+// DryRunSquareLayoutResponse is the response type for the DryRunSquareLayout
+// gRPC method.
+type DryRunSquareLayoutResponse struct {
+	// fits is true if the BlobTx is included in the square.
+	Fits bool `protobuf:"varint,1,opt,name=fits,proto3" json:"fits,omitempty"`
+	// start_share_index is the index of the first share of the first blob of
+	// the BlobTx in the square. It is only set if the BlobTx fits.
+	StartShareIndex uint32 `protobuf:"varint,2,opt,name=start_share_index,json=startShareIndex,proto3" json:"start_share_index,omitempty"`
+	// square_size is the size of the square including the BlobTx if it fits.
+	SquareSize uint64 `protobuf:"varint,3,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// max_square_size is the max effective square size the square is built
+	// under.
+	MaxSquareSize uint64 `protobuf:"varint,4,opt,name=max_square_size,json=maxSquareSize,proto3" json:"max_square_size,omitempty"`
+	// displaced_tx_ids are the hex encoded hashes of the mempool transactions
+	// that are included in the square without the BlobTx but not with it.
+	DisplacedTxIds []string `protobuf:"bytes,5,rep,name=displaced_tx_ids,json=displacedTxIds,proto3" json:"displaced_tx_ids,omitempty"`
+	// mempool_txs is the number of mempool transactions the square is built
+	// from.
+	MempoolTxs uint64 `protobuf:"varint,6,opt,name=mempool_txs,json=mempoolTxs,proto3" json:"mempool_txs,omitempty"`
+}
+
+func (m *DryRunSquareLayoutResponse) Reset()         { *m = DryRunSquareLayoutResponse{} }
+func (m *DryRunSquareLayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunSquareLayoutResponse) ProtoMessage()    {}
+func (*DryRunSquareLayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{8}
+}
+func (m *DryRunSquareLayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunSquareLayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunSquareLayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunSquareLayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunSquareLayoutResponse.Merge(m, src)
+}
+func (m *DryRunSquareLayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunSquareLayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunSquareLayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunSquareLayoutResponse proto.InternalMessageInfo
+
+func (m *DryRunSquareLayoutResponse) GetFits() bool {
+	if m != nil {
+		return m.Fits
+	}
+	return false
+}
+
+func (m *DryRunSquareLayoutResponse) GetStartShareIndex() uint32 {
+	if m != nil {
+		return m.StartShareIndex
+	}
+	return 0
+}
+
+func (m *DryRunSquareLayoutResponse) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *DryRunSquareLayoutResponse) GetMaxSquareSize() uint64 {
+	if m != nil {
+		return m.MaxSquareSize
+	}
+	return 0
+}
+
+func (m *DryRunSquareLayoutResponse) GetDisplacedTxIds() []string {
+	if m != nil {
+		return m.DisplacedTxIds
+	}
+	return nil
+}
+
+func (m *DryRunSquareLayoutResponse) GetMempoolTxs() uint64 {
+	if m != nil {
+		return m.MempoolTxs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TxStatusRequest)(nil), "celestia.core.v1.tx.TxStatusRequest")
 	proto.RegisterType((*TxStatusResponse)(nil), "celestia.core.v1.tx.TxStatusResponse")
+	proto.RegisterType((*TxStatusBatchRequest)(nil), "celestia.core.v1.tx.TxStatusBatchRequest")
+	proto.RegisterType((*TxStatusBatchResponse)(nil), "celestia.core.v1.tx.TxStatusBatchResponse")
+	proto.RegisterType((*TxStatusResult)(nil), "celestia.core.v1.tx.TxStatusResult")
+	proto.RegisterType((*SubscribeTxStatusRequest)(nil), "celestia.core.v1.tx.SubscribeTxStatusRequest")
+	proto.RegisterType((*TxStatusEvent)(nil), "celestia.core.v1.tx.TxStatusEvent")
+	proto.RegisterType((*DryRunSquareLayoutRequest)(nil), "celestia.core.v1.tx.DryRunSquareLayoutRequest")
+	proto.RegisterType((*DryRunSquareLayoutResponse)(nil), "celestia.core.v1.tx.DryRunSquareLayoutResponse")
 }
 
 func init() { proto.RegisterFile("celestia/core/v1/tx/tx.proto", fileDescriptor_7d8b070565b0dcb6) }
 
 var fileDescriptor_7d8b070565b0dcb6 = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xcd, 0x4a, 0x33, 0x31,
-	0x14, 0x6d, 0xfa, 0xc7, 0xf7, 0x05, 0xfa, 0x7d, 0x92, 0x8a, 0x0c, 0xa5, 0x0c, 0xa5, 0xb4, 0xd2,
-	0x8d, 0x13, 0xaa, 0x6f, 0xa0, 0xab, 0x6e, 0xc7, 0xae, 0xdc, 0x94, 0xe9, 0xcc, 0x65, 0x1a, 0xa8,
-	0x93, 0x31, 0xb9, 0x53, 0x02, 0xd2, 0x8d, 0xbe, 0x80, 0x20, 0xbe, 0x93, 0xcb, 0x82, 0x1b, 0x97,
-	0xd2, 0xfa, 0x20, 0x32, 0x99, 0xb6, 0x82, 0x14, 0x5c, 0x04, 0x72, 0xee, 0x39, 0x37, 0x27, 0xf7,
-	0x5c, 0xda, 0x0e, 0x61, 0x0e, 0x1a, 0x45, 0xc0, 0x43, 0xa9, 0x80, 0x2f, 0x86, 0x1c, 0x0d, 0x47,
-	0xe3, 0xa5, 0x4a, 0xa2, 0x64, 0xcd, 0x1d, 0xeb, 0xe5, 0xac, 0xb7, 0x18, 0x7a, 0x68, 0x5a, 0xed,
-	0x58, 0xca, 0x78, 0x0e, 0x3c, 0x48, 0x05, 0x0f, 0x92, 0x44, 0x62, 0x80, 0x42, 0x26, 0xba, 0x68,
-	0xe9, 0x9e, 0xd2, 0xff, 0x63, 0x73, 0x8d, 0x01, 0x66, 0xda, 0x87, 0xbb, 0x0c, 0x34, 0xb2, 0x26,
-	0xad, 0xa1, 0x99, 0x88, 0xc8, 0x21, 0x1d, 0x32, 0xf8, 0xeb, 0x57, 0xd1, 0x8c, 0xa2, 0xee, 0x0b,
-	0xa1, 0x47, 0xdf, 0x42, 0x9d, 0xca, 0x44, 0x03, 0x3b, 0xa1, 0xf5, 0x19, 0x88, 0x78, 0x86, 0x56,
-	0x5a, 0xf1, 0xb7, 0x88, 0x1d, 0xd3, 0x9a, 0x48, 0x22, 0x30, 0x4e, 0xb9, 0x43, 0x06, 0x0d, 0xbf,
-	0x00, 0xac, 0x4f, 0xff, 0x81, 0x81, 0x30, 0xcb, 0xed, 0x27, 0xa1, 0x8c, 0xc0, 0xa9, 0x58, 0xba,
-	0xb1, 0xaf, 0x5e, 0xc9, 0x08, 0xf2, 0x66, 0x50, 0x4a, 0x2a, 0xa7, 0x6a, 0xed, 0x0b, 0x90, 0x5b,
-	0x69, 0x6b, 0xee, 0xd4, 0x6c, 0x79, 0x8b, 0xce, 0x1f, 0x09, 0x2d, 0x8f, 0x0d, 0x5b, 0xd2, 0x3f,
-	0xbb, 0xdf, 0xb1, 0x9e, 0x77, 0x20, 0x06, 0xef, 0xc7, 0x94, 0xad, 0xfe, 0x2f, 0xaa, 0x62, 0xc4,
-	0x6e, 0xef, 0xe1, 0xed, 0xf3, 0xb9, 0xec, 0xb2, 0x36, 0x3f, 0x94, 0xfc, 0xbd, 0x0d, 0x6a, 0x79,
-	0x39, 0x7a, 0x5d, 0xbb, 0x64, 0xb5, 0x76, 0xc9, 0xc7, 0xda, 0x25, 0x4f, 0x1b, 0xb7, 0xb4, 0xda,
-	0xb8, 0xa5, 0xf7, 0x8d, 0x5b, 0xba, 0xe1, 0xb1, 0xc0, 0x59, 0x36, 0xf5, 0x42, 0x79, 0xbb, 0x7f,
-	0x41, 0xaa, 0x78, 0x7f, 0x3f, 0x0b, 0xd2, 0x94, 0xe7, 0x27, 0x56, 0x69, 0xc8, 0xd1, 0x4c, 0xeb,
-	0x76, 0x2f, 0x17, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x2f, 0x1b, 0xd1, 0xea, 0x01, 0x00,
-	0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0x66, 0x60, 0x77, 0xc5, 0x17, 0x97, 0x8f, 0x01, 0xb4, 0x6e, 0xc8, 0x42, 0x2a, 0xe0, 0xba,
+	0xc9, 0xb6, 0x82, 0x9e, 0x48, 0x8c, 0x09, 0xea, 0x81, 0xc4, 0x53, 0x77, 0x0f, 0xc6, 0x4b, 0x33,
+	0xdb, 0x8e, 0xbb, 0x35, 0xdd, 0x4e, 0xe9, 0x4c, 0xc9, 0x80, 0xe1, 0xe2, 0xdd, 0xc4, 0xc4, 0xf8,
+	0x0f, 0x8c, 0xbf, 0xc5, 0x23, 0x89, 0x17, 0x8f, 0x06, 0xfc, 0x01, 0xfe, 0x04, 0xd3, 0x69, 0xb7,
+	0x2c, 0x50, 0x3e, 0x2e, 0x1e, 0x9a, 0x74, 0xde, 0xaf, 0xe7, 0x79, 0x9f, 0x79, 0x5a, 0x58, 0x72,
+	0xa8, 0x4f, 0xb9, 0xf0, 0x88, 0xe9, 0xb0, 0x88, 0x9a, 0x7b, 0x1b, 0xa6, 0x90, 0xa6, 0x90, 0x46,
+	0x18, 0x31, 0xc1, 0xf0, 0xfc, 0x30, 0x6b, 0x24, 0x59, 0x63, 0x6f, 0xc3, 0x10, 0xb2, 0xb6, 0xd4,
+	0x63, 0xac, 0xe7, 0x53, 0x93, 0x84, 0x9e, 0x49, 0x82, 0x80, 0x09, 0x22, 0x3c, 0x16, 0xf0, 0xb4,
+	0x45, 0x5f, 0x87, 0x99, 0x8e, 0x6c, 0x0b, 0x22, 0x62, 0x6e, 0xd1, 0xdd, 0x98, 0x72, 0x81, 0xe7,
+	0xa1, 0x2c, 0xa4, 0xed, 0xb9, 0x1a, 0x5a, 0x41, 0x8d, 0xdb, 0x56, 0x49, 0xc8, 0x1d, 0x57, 0xff,
+	0x8a, 0x60, 0xf6, 0xb4, 0x90, 0x87, 0x2c, 0xe0, 0x14, 0xdf, 0x85, 0x4a, 0x9f, 0x7a, 0xbd, 0xbe,
+	0x50, 0xa5, 0x13, 0x56, 0x76, 0xc2, 0x0b, 0x50, 0xf6, 0x02, 0x97, 0x4a, 0x6d, 0x7c, 0x05, 0x35,
+	0xaa, 0x56, 0x7a, 0xc0, 0x6b, 0x30, 0x4d, 0x25, 0x75, 0xe2, 0x04, 0xde, 0x76, 0x98, 0x4b, 0xb5,
+	0x09, 0x95, 0xae, 0xe6, 0xd1, 0x17, 0xcc, 0xa5, 0x49, 0x33, 0x8d, 0x22, 0x16, 0x69, 0x25, 0x05,
+	0x9f, 0x1e, 0x12, 0x28, 0xae, 0xc0, 0xb5, 0xb2, 0x0a, 0x67, 0x27, 0xbd, 0x05, 0x0b, 0x43, 0x5a,
+	0xdb, 0x44, 0x38, 0xfd, 0xe1, 0x12, 0x8b, 0x50, 0x51, 0x4b, 0x70, 0x0d, 0xad, 0x4c, 0x24, 0x63,
+	0x92, 0x2d, 0xb8, 0xfe, 0x06, 0x16, 0xcf, 0x95, 0x67, 0xab, 0x3c, 0x87, 0xc9, 0x74, 0x22, 0x4d,
+	0x3b, 0xa6, 0x36, 0x1f, 0x18, 0x05, 0x6a, 0x1a, 0x23, 0x1a, 0xc4, 0xbe, 0xb0, 0xf2, 0x26, 0xdd,
+	0x85, 0xe9, 0xb3, 0xb9, 0x42, 0x1d, 0xf1, 0xb3, 0x7c, 0x8f, 0x44, 0x9b, 0xa9, 0xcd, 0xb5, 0xeb,
+	0x50, 0x14, 0xbd, 0x7c, 0xdd, 0x0d, 0xd0, 0xda, 0x71, 0x97, 0x3b, 0x91, 0xd7, 0xa5, 0xe7, 0xef,
+	0xed, 0x92, 0x95, 0x1d, 0xa8, 0x0e, 0x2b, 0x5f, 0xed, 0xd1, 0xe0, 0xff, 0xf0, 0x7a, 0x0a, 0xf7,
+	0x5f, 0x46, 0xfb, 0x56, 0x1c, 0xb4, 0x77, 0x63, 0x12, 0xd1, 0xd7, 0x64, 0x9f, 0xc5, 0x62, 0x48,
+	0xec, 0x1e, 0xdc, 0xea, 0xfa, 0xac, 0x6b, 0x0b, 0xa9, 0x20, 0xef, 0x58, 0x95, 0xe4, 0xd8, 0x91,
+	0xfa, 0x5f, 0x04, 0xb5, 0xa2, 0xb6, 0xec, 0x4e, 0x30, 0x94, 0xde, 0x79, 0x82, 0xab, 0xa6, 0x49,
+	0x4b, 0xbd, 0xe3, 0x26, 0xcc, 0x71, 0x41, 0x22, 0x61, 0xf3, 0x3e, 0x89, 0xa8, 0x3d, 0x6a, 0xb3,
+	0x19, 0x95, 0x68, 0x27, 0xf1, 0x1d, 0x65, 0xb8, 0x65, 0x98, 0xe2, 0x6a, 0xae, 0xcd, 0xbd, 0x83,
+	0xd4, 0x6d, 0x25, 0x0b, 0xd2, 0x50, 0xdb, 0x3b, 0xa0, 0x78, 0x1d, 0x66, 0x06, 0x44, 0xda, 0xa3,
+	0x45, 0x25, 0x55, 0x54, 0x1d, 0x10, 0xd9, 0x3e, 0xad, 0x6b, 0xc0, 0xac, 0xeb, 0xf1, 0xd0, 0x27,
+	0x0e, 0x75, 0xed, 0x4c, 0xe3, 0xb2, 0xd2, 0x78, 0x3a, 0x8f, 0x77, 0x12, 0xb1, 0x13, 0xc8, 0x01,
+	0x1d, 0x84, 0x8c, 0xf9, 0xb6, 0x90, 0x5c, 0xab, 0xa4, 0x90, 0x59, 0xa8, 0x23, 0xf9, 0xe6, 0xf7,
+	0x12, 0x8c, 0x77, 0x24, 0x3e, 0x84, 0xc9, 0xa1, 0x96, 0x78, 0xf5, 0x1a, 0xa9, 0x95, 0x88, 0xb5,
+	0x9b, 0x5d, 0x88, 0xbe, 0xfa, 0xf1, 0xe7, 0x9f, 0x2f, 0xe3, 0x75, 0xbc, 0x64, 0x16, 0xfd, 0x29,
+	0x3e, 0x28, 0xf2, 0x87, 0xf8, 0x13, 0x82, 0xea, 0x99, 0xef, 0x00, 0x3f, 0xba, 0x72, 0xfc, 0xe8,
+	0xa7, 0x55, 0x6b, 0xde, 0xa4, 0x34, 0xa3, 0xb3, 0xa6, 0xe8, 0x2c, 0x6f, 0xa1, 0xa6, 0x5e, 0x2b,
+	0x64, 0xd4, 0x55, 0xe8, 0xef, 0x61, 0xee, 0x82, 0xad, 0x71, 0xab, 0x10, 0xe7, 0x32, 0xfb, 0xd7,
+	0xf4, 0x2b, 0x69, 0x29, 0xeb, 0x37, 0xd0, 0x63, 0x84, 0xbf, 0x21, 0xc0, 0x17, 0x4d, 0x87, 0x8d,
+	0xc2, 0xf6, 0x4b, 0x4d, 0x5d, 0x33, 0x6f, 0x5c, 0x9f, 0x49, 0xd1, 0x52, 0x52, 0x3c, 0xd4, 0xf5,
+	0x42, 0x1d, 0x32, 0x0f, 0xfa, 0xaa, 0x67, 0x0b, 0x35, 0xb7, 0x77, 0x7e, 0x1c, 0xd7, 0xd1, 0xd1,
+	0x71, 0x1d, 0xfd, 0x3e, 0xae, 0xa3, 0xcf, 0x27, 0xf5, 0xb1, 0xa3, 0x93, 0xfa, 0xd8, 0xaf, 0x93,
+	0xfa, 0xd8, 0x5b, 0xb3, 0xe7, 0x89, 0x7e, 0xdc, 0x35, 0x1c, 0x36, 0xc8, 0x47, 0xb1, 0xa8, 0x97,
+	0xbf, 0xb7, 0x48, 0x18, 0x9a, 0xc9, 0xd3, 0x8b, 0x42, 0xc7, 0x14, 0xb2, 0x5b, 0x51, 0xbf, 0xfa,
+	0x27, 0xff, 0x06, 0x00, 0x54, 0x43, 0x36, 0x62, 0x3d, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -207,6 +630,24 @@ type TxClient interface {
 	// - Evicted
 	// - Unknown
 	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+	// TxStatusBatch returns the status of multiple transactions at once. The
+	// statuses are returned in the order of the requested transactions.
+	TxStatusBatch(ctx context.Context, in *TxStatusBatchRequest, opts ...grpc.CallOption) (*TxStatusBatchResponse, error)
+	// SubscribeTxStatus streams the status of the transactions of every request
+	// sent on the stream. The current status of every transaction is sent once
+	// it is requested, followed by an event each time its status changes. A
+	// transaction is no longer watched once it is committed, evicted or unknown
+	// (i.e. rejected). The stream ends once the client stops sending requests
+	// and none of the transactions are watched anymore. The RPC is
+	// bidirectional rather than server streaming so that a client can add
+	// transactions to an open stream instead of opening one per set of hashes.
+	SubscribeTxStatus(ctx context.Context, opts ...grpc.CallOption) (Tx_SubscribeTxStatusClient, error)
+	// DryRunSquareLayout builds the square the next proposal would be built
+	// from given the transactions in the mempool and a BlobTx, without
+	// submitting the BlobTx. The BlobTx is prioritised against the mempool
+	// transactions by its gas price. Only the mempool transactions with the
+	// highest priority are taken into account.
+	DryRunSquareLayout(ctx context.Context, in *DryRunSquareLayoutRequest, opts ...grpc.CallOption) (*DryRunSquareLayoutResponse, error)
 }
 
 type txClient struct {
@@ -226,6 +667,55 @@ func (c *txClient) TxStatus(ctx context.Context, in *TxStatusRequest, opts ...gr
 	return out, nil
 }
 
+func (c *txClient) TxStatusBatch(ctx context.Context, in *TxStatusBatchRequest, opts ...grpc.CallOption) (*TxStatusBatchResponse, error) {
+	out := new(TxStatusBatchResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.tx.Tx/TxStatusBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txClient) SubscribeTxStatus(ctx context.Context, opts ...grpc.CallOption) (Tx_SubscribeTxStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tx_serviceDesc.Streams[0], "/celestia.core.v1.tx.Tx/SubscribeTxStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &txSubscribeTxStatusClient{stream}
+	return x, nil
+}
+
+type Tx_SubscribeTxStatusClient interface {
+	Send(*SubscribeTxStatusRequest) error
+	Recv() (*TxStatusEvent, error)
+	grpc.ClientStream
+}
+
+type txSubscribeTxStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *txSubscribeTxStatusClient) Send(m *SubscribeTxStatusRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *txSubscribeTxStatusClient) Recv() (*TxStatusEvent, error) {
+	m := new(TxStatusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *txClient) DryRunSquareLayout(ctx context.Context, in *DryRunSquareLayoutRequest, opts ...grpc.CallOption) (*DryRunSquareLayoutResponse, error) {
+	out := new(DryRunSquareLayoutResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.tx.Tx/DryRunSquareLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TxServer is the server API for Tx service.
 type TxServer interface {
 	// TxStatus returns the status of a transaction. There are four possible states:
@@ -234,6 +724,24 @@ type TxServer interface {
 	// - Evicted
 	// - Unknown
 	TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error)
+	// TxStatusBatch returns the status of multiple transactions at once. The
+	// statuses are returned in the order of the requested transactions.
+	TxStatusBatch(context.Context, *TxStatusBatchRequest) (*TxStatusBatchResponse, error)
+	// SubscribeTxStatus streams the status of the transactions of every request
+	// sent on the stream. The current status of every transaction is sent once
+	// it is requested, followed by an event each time its status changes. A
+	// transaction is no longer watched once it is committed, evicted or unknown
+	// (i.e. rejected). The stream ends once the client stops sending requests
+	// and none of the transactions are watched anymore. The RPC is
+	// bidirectional rather than server streaming so that a client can add
+	// transactions to an open stream instead of opening one per set of hashes.
+	SubscribeTxStatus(Tx_SubscribeTxStatusServer) error
+	// DryRunSquareLayout builds the square the next proposal would be built
+	// from given the transactions in the mempool and a BlobTx, without
+	// submitting the BlobTx. The BlobTx is prioritised against the mempool
+	// transactions by its gas price. Only the mempool transactions with the
+	// highest priority are taken into account.
+	DryRunSquareLayout(context.Context, *DryRunSquareLayoutRequest) (*DryRunSquareLayoutResponse, error)
 }
 
 // UnimplementedTxServer can be embedded to have forward compatible implementations.
@@ -243,6 +751,15 @@ type UnimplementedTxServer struct {
 func (*UnimplementedTxServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
 }
+func (*UnimplementedTxServer) TxStatusBatch(ctx context.Context, req *TxStatusBatchRequest) (*TxStatusBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatusBatch not implemented")
+}
+func (*UnimplementedTxServer) SubscribeTxStatus(srv Tx_SubscribeTxStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxStatus not implemented")
+}
+func (*UnimplementedTxServer) DryRunSquareLayout(ctx context.Context, req *DryRunSquareLayoutRequest) (*DryRunSquareLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunSquareLayout not implemented")
+}
 
 func RegisterTxServer(s grpc1.Server, srv TxServer) {
 	s.RegisterService(&_Tx_serviceDesc, srv)
@@ -266,16 +783,93 @@ func _Tx_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Tx_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.core.v1.tx.Tx",
-	HandlerType: (*TxServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
+func _Tx_TxStatusBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatusBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServer).TxStatusBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.tx.Tx/TxStatusBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServer).TxStatusBatch(ctx, req.(*TxStatusBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tx_SubscribeTxStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TxServer).SubscribeTxStatus(&txSubscribeTxStatusServer{stream})
+}
+
+type Tx_SubscribeTxStatusServer interface {
+	Send(*TxStatusEvent) error
+	Recv() (*SubscribeTxStatusRequest, error)
+	grpc.ServerStream
+}
+
+type txSubscribeTxStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *txSubscribeTxStatusServer) Send(m *TxStatusEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *txSubscribeTxStatusServer) Recv() (*SubscribeTxStatusRequest, error) {
+	m := new(SubscribeTxStatusRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Tx_DryRunSquareLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunSquareLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServer).DryRunSquareLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.tx.Tx/DryRunSquareLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServer).DryRunSquareLayout(ctx, req.(*DryRunSquareLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Tx_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.tx.Tx",
+	HandlerType: (*TxServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
 			MethodName: "TxStatus",
 			Handler:    _Tx_TxStatus_Handler,
 		},
+		{
+			MethodName: "TxStatusBatch",
+			Handler:    _Tx_TxStatusBatch_Handler,
+		},
+		{
+			MethodName: "DryRunSquareLayout",
+			Handler:    _Tx_DryRunSquareLayout_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTxStatus",
+			Handler:       _Tx_SubscribeTxStatus_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/core/v1/tx/tx.proto",
 }
 
@@ -361,6 +955,283 @@ func (m *TxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxStatusBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for iNdEx := len(m.TxIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxIds[iNdEx])
+			copy(dAtA[i:], m.TxIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TxIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatusBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatusResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeTxStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeTxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeTxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for iNdEx := len(m.TxIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxIds[iNdEx])
+			copy(dAtA[i:], m.TxIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TxIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatusEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRunSquareLayoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunSquareLayoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunSquareLayoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlobTx) > 0 {
+		i -= len(m.BlobTx)
+		copy(dAtA[i:], m.BlobTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlobTx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRunSquareLayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunSquareLayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunSquareLayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MempoolTxs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MempoolTxs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DisplacedTxIds) > 0 {
+		for iNdEx := len(m.DisplacedTxIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisplacedTxIds[iNdEx])
+			copy(dAtA[i:], m.DisplacedTxIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DisplacedTxIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxSquareSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSquareSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartShareIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartShareIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Fits {
+		i--
+		if m.Fits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -404,20 +1275,761 @@ func (m *TxStatusResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *TxStatusBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for _, s := range m.TxIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxStatusBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxStatusResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeTxStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for _, s := range m.TxIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxStatusEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DryRunSquareLayoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlobTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DryRunSquareLayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fits {
+		n += 2
+	}
+	if m.StartShareIndex != 0 {
+		n += 1 + sovTx(uint64(m.StartShareIndex))
+	}
+	if m.SquareSize != 0 {
+		n += 1 + sovTx(uint64(m.SquareSize))
+	}
+	if m.MaxSquareSize != 0 {
+		n += 1 + sovTx(uint64(m.MaxSquareSize))
+	}
+	if len(m.DisplacedTxIds) > 0 {
+		for _, s := range m.DisplacedTxIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MempoolTxs != 0 {
+		n += 1 + sovTx(uint64(m.MempoolTxs))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCode", wireType)
+			}
+			m.ExecutionCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxIds = append(m.TxIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &TxStatusResult{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &TxStatusResponse{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *SubscribeTxStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeTxStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeTxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxIds = append(m.TxIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *TxStatusEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -440,10 +2052,10 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxStatusEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxStatusEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -478,6 +2090,42 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.TxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &TxStatusResponse{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -499,7 +2147,7 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *DryRunSquareLayoutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -522,17 +2170,101 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRunSquareLayoutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRunSquareLayoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobTx = append(m.BlobTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlobTx == nil {
+				m.BlobTx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryRunSquareLayoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunSquareLayoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunSquareLayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fits", wireType)
 			}
-			m.Height = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -542,16 +2274,17 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Fits = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartShareIndex", wireType)
 			}
-			m.Index = 0
+			m.StartShareIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -561,16 +2294,16 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
+				m.StartShareIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
 			}
-			m.ExecutionCode = 0
+			m.SquareSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -580,16 +2313,16 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutionCode |= uint32(b&0x7F) << shift
+				m.SquareSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSquareSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxSquareSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -599,27 +2332,14 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxSquareSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplacedTxIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -647,8 +2367,27 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.DisplacedTxIds = append(m.DisplacedTxIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolTxs", wireType)
+			}
+			m.MempoolTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MempoolTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -26,6 +26,9 @@ type Configurator struct {
 	queryServer            pbgrpc.Server
 	// acceptedMessages is a map from appVersion -> msgTypeURL -> struct{}.
 	acceptedMessages map[uint64]map[string]struct{}
+	// registeredMethods is the set of the full names of the msg service
+	// methods registered with the msgServer.
+	registeredMethods map[string]struct{}
 	// migrations is a map of moduleName -> fromVersion -> migration script handler.
 	migrations map[string]map[uint64]module.MigrationHandler
 }
@@ -33,11 +36,12 @@ type Configurator struct {
 // NewConfigurator returns a new Configurator instance.
 func NewConfigurator(cdc codec.Codec, msgServer, queryServer pbgrpc.Server) Configurator {
 	return Configurator{
-		cdc:              cdc,
-		msgServer:        msgServer,
-		queryServer:      queryServer,
-		migrations:       map[string]map[uint64]module.MigrationHandler{},
-		acceptedMessages: map[uint64]map[string]struct{}{},
+		cdc:               cdc,
+		msgServer:         msgServer,
+		queryServer:       queryServer,
+		migrations:        map[string]map[uint64]module.MigrationHandler{},
+		acceptedMessages:  map[uint64]map[string]struct{}{},
+		registeredMethods: map[string]struct{}{},
 	}
 }
 
@@ -50,8 +54,9 @@ func (c *Configurator) WithVersions(fromVersion, toVersion uint64) module.Config
 // MsgServer implements the Configurator.MsgServer method.
 func (c Configurator) MsgServer() pbgrpc.Server {
 	return &serverWrapper{
-		addMessages: c.addMessages,
-		msgServer:   c.msgServer,
+		addMessages:       c.addMessages,
+		msgServer:         c.msgServer,
+		registeredMethods: c.registeredMethods,
 	}
 }
 
@@ -12,12 +12,14 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
 )
 
 func TestConfigurator(t *testing.T) {
@@ -25,8 +27,14 @@ func TestConfigurator(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		t.Cleanup(mockCtrl.Finish)
 
+		// registered maps the registered service names to their methods
+		registered := map[string][]string{}
 		mockServer := mocks.NewMockServer(mockCtrl)
-		mockServer.EXPECT().RegisterService(gomock.Any(), gomock.Any()).Times(2).Return()
+		mockServer.EXPECT().RegisterService(gomock.Any(), gomock.Any()).Times(3).Do(func(sd *grpc.ServiceDesc, _ interface{}) {
+			for _, method := range sd.Methods {
+				registered[sd.ServiceName] = append(registered[sd.ServiceName], method.MethodName)
+			}
+		})
 
 		config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 		configurator := module.NewConfigurator(config.Codec, mockServer, mockServer)
@@ -37,11 +45,12 @@ func TestConfigurator(t *testing.T) {
 		stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 		require.NoError(t, stateStore.LoadLatestVersion())
 
-		keeper := signal.NewKeeper(config.Codec, storeKey, nil)
+		subspace := paramtypes.NewSubspace(config.Codec, config.Amino, sdk.NewKVStoreKey(paramtypes.StoreKey), sdk.NewTransientStoreKey(paramtypes.TStoreKey), signaltypes.ModuleName)
+		keeper := signal.NewKeeper(config.Codec, storeKey, subspace, nil, "")
 		require.NotNil(t, keeper)
-		upgradeModule := signal.NewAppModule(keeper)
 		manager, err := module.NewManager([]module.VersionedModule{
-			{Module: upgradeModule, FromVersion: 2, ToVersion: 2},
+			{Module: signal.NewAppModuleV3(keeper), FromVersion: 2, ToVersion: 3},
+			{Module: signal.NewAppModule(keeper), FromVersion: 4, ToVersion: 4},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, manager)
@@ -53,7 +62,19 @@ func TestConfigurator(t *testing.T) {
 				"/celestia.signal.v1.MsgSignalVersion": {},
 				"/celestia.signal.v1.MsgTryUpgrade":    {},
 			},
+			3: {
+				"/celestia.signal.v1.MsgSignalVersion": {},
+				"/celestia.signal.v1.MsgTryUpgrade":    {},
+			},
+			4: {
+				"/celestia.signal.v1.MsgSignalVersion": {},
+				"/celestia.signal.v1.MsgTryUpgrade":    {},
+				"/celestia.signal.v1.MsgCancelUpgrade": {},
+			},
 		}, acceptedMessages)
+		// the methods shared by both versions of the module are only
+		// registered once
+		assert.ElementsMatch(t, []string{"SignalVersion", "TryUpgrade", "CancelUpgrade"}, registered["celestia.signal.v1.Msg"])
 	})
 
 	t.Run("register migration", func(t *testing.T) {
@@ -13,9 +13,15 @@ import (
 // logic to extract all the sdk.Msg types that the service declares in its
 // methods and fires a callback to add them to the configurator. This allows us
 // to create a map of which messages are accepted across which versions.
+//
+// The versions of a module with different consensus versions register the
+// services they share once per version. The methods that were already
+// registered by another version are only added to the accepted messages of
+// this version and aren't registered again with the msgServer.
 type serverWrapper struct {
-	addMessages func(msgs []string)
-	msgServer   pbgrpc.Server
+	addMessages       func(msgs []string)
+	msgServer         pbgrpc.Server
+	registeredMethods map[string]struct{}
 }
 
 func (s *serverWrapper) RegisterService(sd *grpc.ServiceDesc, v interface{}) {
@@ -32,8 +38,22 @@ func (s *serverWrapper) RegisterService(sd *grpc.ServiceDesc, v interface{}) {
 		}, noopInterceptor)
 	}
 	s.addMessages(msgs)
+
+	unregistered := *sd
+	unregistered.Methods = nil
+	for _, method := range sd.Methods {
+		fullName := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		if _, ok := s.registeredMethods[fullName]; ok {
+			continue
+		}
+		s.registeredMethods[fullName] = struct{}{}
+		unregistered.Methods = append(unregistered.Methods, method)
+	}
+	if len(unregistered.Methods) == 0 {
+		return
+	}
 	// call the underlying msg server to actually register the grpc server
-	s.msgServer.RegisterService(sd, v)
+	s.msgServer.RegisterService(&unregistered, v)
 }
 
 func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
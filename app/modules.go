@@ -12,6 +12,8 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 	"github.com/celestiaorg/celestia-app/v3/x/mint"
 	minttypes "github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
 	"github.com/celestiaorg/celestia-app/v3/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
@@ -84,6 +86,7 @@ var (
 		minfee.AppModuleBasic{},
 		packetforward.AppModuleBasic{},
 		icaModule{},
+		namespace.AppModuleBasic{},
 	)
 
 	// ModuleEncodingRegisters keeps track of all the module methods needed to
@@ -96,95 +99,107 @@ func (app *App) setupModuleManager(skipGenesisInvariants bool) error {
 	app.manager, err = module.NewManager([]module.VersionedModule{
 		{
 			Module:      genutil.NewAppModule(app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx, app.txConfig),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      auth.NewAppModule(app.appCodec, app.AccountKeeper, nil),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      bank.NewAppModule(app.appCodec, app.BankKeeper, app.AccountKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      capability.NewAppModule(app.appCodec, *app.CapabilityKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      feegrantmodule.NewAppModule(app.appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      gov.NewAppModule(app.appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+			FromVersion: v1, ToVersion: v4,
+		},
+		{
+			Module:      mint.NewAppModuleV1(app.appCodec, app.MintKeeper, app.AccountKeeper),
 			FromVersion: v1, ToVersion: v3,
 		},
 		{
 			Module:      mint.NewAppModule(app.appCodec, app.MintKeeper, app.AccountKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v4, ToVersion: v4,
 		},
 		{
 			Module:      slashing.NewAppModule(app.appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      distr.NewAppModule(app.appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      staking.NewAppModule(app.appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      evidence.NewAppModule(app.EvidenceKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      authzmodule.NewAppModule(app.appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      ibc.NewAppModule(app.IBCKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      params.NewAppModule(app.ParamsKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      transfer.NewAppModule(app.TransferKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      blob.NewAppModule(app.appCodec, app.BlobKeeper),
-			FromVersion: v1, ToVersion: v3,
+			FromVersion: v1, ToVersion: v4,
 		},
 		{
 			Module:      blobstream.NewAppModule(app.appCodec, app.BlobstreamKeeper),
 			FromVersion: v1, ToVersion: v1,
 		},
 		{
-			Module:      signal.NewAppModule(app.SignalKeeper),
+			Module:      signal.NewAppModuleV3(app.SignalKeeper),
 			FromVersion: v2, ToVersion: v3,
 		},
+		{
+			Module:      signal.NewAppModule(app.SignalKeeper),
+			FromVersion: v4, ToVersion: v4,
+		},
 		{
 			Module:      minfee.NewAppModule(app.ParamsKeeper),
-			FromVersion: v2, ToVersion: v3,
+			FromVersion: v2, ToVersion: v4,
 		},
 		{
 			Module:      packetforward.NewAppModule(app.PacketForwardKeeper),
-			FromVersion: v2, ToVersion: v3,
+			FromVersion: v2, ToVersion: v4,
 		},
 		{
 			Module:      ica.NewAppModule(nil, &app.ICAHostKeeper),
-			FromVersion: v2, ToVersion: v3,
+			FromVersion: v2, ToVersion: v4,
+		},
+		{
+			Module:      namespace.NewAppModule(app.NamespaceKeeper),
+			FromVersion: v4, ToVersion: v4,
 		},
 	})
 	if err != nil {
@@ -222,6 +237,7 @@ func (app *App) setModuleOrder() {
 		minfee.ModuleName,
 		icatypes.ModuleName,
 		packetforwardtypes.ModuleName,
+		namespacetypes.ModuleName,
 	)
 
 	app.manager.SetOrderEndBlockers(
@@ -248,6 +264,7 @@ func (app *App) setModuleOrder() {
 		minfee.ModuleName,
 		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
+		namespacetypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -281,6 +298,7 @@ func (app *App) setModuleOrder() {
 		signaltypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
+		namespacetypes.ModuleName,
 	)
 }
 
@@ -297,6 +315,7 @@ func allStoreKeys() []string {
 		icahosttypes.StoreKey,
 		signaltypes.StoreKey,
 		blobtypes.StoreKey,
+		namespacetypes.StoreKey,
 	}
 }
 
@@ -341,7 +360,27 @@ func versionedStoreKeys() map[uint64][]string {
 			stakingtypes.StoreKey,
 			upgradetypes.StoreKey,
 		},
-		v3: { // same as v2
+		v3: {
+			authtypes.StoreKey,
+			authzkeeper.StoreKey,
+			banktypes.StoreKey,
+			blobtypes.StoreKey,
+			capabilitytypes.StoreKey,
+			distrtypes.StoreKey,
+			evidencetypes.StoreKey,
+			feegrant.StoreKey,
+			govtypes.StoreKey,
+			ibchost.StoreKey,
+			ibctransfertypes.StoreKey,
+			icahosttypes.StoreKey,
+			minttypes.StoreKey,
+			packetforwardtypes.StoreKey,
+			signaltypes.StoreKey,
+			slashingtypes.StoreKey,
+			stakingtypes.StoreKey,
+			upgradetypes.StoreKey,
+		},
+		v4: {
 			authtypes.StoreKey,
 			authzkeeper.StoreKey,
 			banktypes.StoreKey,
@@ -355,6 +394,7 @@ func versionedStoreKeys() map[uint64][]string {
 			ibctransfertypes.StoreKey,
 			icahosttypes.StoreKey,
 			minttypes.StoreKey,
+			namespacetypes.StoreKey, // added in v4
 			packetforwardtypes.StoreKey,
 			signaltypes.StoreKey,
 			slashingtypes.StoreKey,
@@ -12,6 +12,7 @@ import (
 	squarev2 "github.com/celestiaorg/go-square/v2"
 	sharev2 "github.com/celestiaorg/go-square/v2/share"
 	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	core "github.com/tendermint/tendermint/proto/tendermint/types"
 	version "github.com/tendermint/tendermint/proto/tendermint/version"
@@ -37,6 +38,7 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.BlobKeeper,
+		app.NamespaceKeeper,
 		app.FeeGrantKeeper,
 		app.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -50,31 +52,7 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 
 	// Build the square from the set of valid and prioritised transactions.
 	// The txs returned are the ones used in the square and block.
-	var (
-		dataSquareBytes [][]byte
-		err             error
-		size            uint64
-	)
-	switch app.AppVersion() {
-	case v3:
-		var dataSquare squarev2.Square
-		dataSquare, txs, err = squarev2.Build(txs,
-			app.MaxEffectiveSquareSize(sdkCtx),
-			appconsts.SubtreeRootThreshold(app.GetBaseApp().AppVersion()),
-		)
-		dataSquareBytes = sharev2.ToBytes(dataSquare)
-		size = uint64(dataSquare.Size())
-	case v2, v1:
-		var dataSquare square.Square
-		dataSquare, txs, err = square.Build(txs,
-			app.MaxEffectiveSquareSize(sdkCtx),
-			appconsts.SubtreeRootThreshold(app.GetBaseApp().AppVersion()),
-		)
-		dataSquareBytes = shares.ToBytes(dataSquare)
-		size = uint64(dataSquare.Size())
-	default:
-		err = fmt.Errorf("unsupported app version: %d", app.AppVersion())
-	}
+	dataSquareBytes, txs, size, err := app.buildSquare(sdkCtx, txs)
 	if err != nil {
 		panic(err)
 	}
@@ -82,7 +60,7 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 	// Erasure encode the data square to create the extended data square (eds).
 	// Note: uses the nmt wrapper to construct the tree. See
 	// pkg/wrapper/nmt_wrapper.go for more information.
-	eds, err := da.ExtendShares(dataSquareBytes)
+	eds, err := app.extender.ExtendShares(dataSquareBytes)
 	if err != nil {
 		app.Logger().Error(
 			"failure to erasure the data square while creating a proposal block",
@@ -102,6 +80,10 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		panic(err)
 	}
 
+	// Cache the eds so that it isn't recomputed when processing this proposal
+	// or serving proofs for this height once it is committed.
+	app.edsCache.Add(req.Height, eds, dah)
+
 	// Tendermint doesn't need to use any of the erasure data because only the
 	// protobuf encoded version of the block data is gossiped. Therefore, the
 	// eds is not returned here.
@@ -113,3 +95,35 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		},
 	}
 }
+
+// buildSquare builds the data square from the set of prioritised transactions.
+// It returns the shares of the square, the transactions used in the square and
+// the size of the square.
+func (app *App) buildSquare(ctx sdk.Context, txs [][]byte) ([][]byte, [][]byte, uint64, error) {
+	var (
+		dataSquareBytes [][]byte
+		err             error
+		size            uint64
+	)
+	switch app.AppVersion() {
+	case v4, v3:
+		var dataSquare squarev2.Square
+		dataSquare, txs, err = squarev2.Build(txs,
+			app.MaxEffectiveSquareSize(ctx),
+			appconsts.SubtreeRootThreshold(app.GetBaseApp().AppVersion()),
+		)
+		dataSquareBytes = sharev2.ToBytes(dataSquare)
+		size = uint64(dataSquare.Size())
+	case v2, v1:
+		var dataSquare square.Square
+		dataSquare, txs, err = square.Build(txs,
+			app.MaxEffectiveSquareSize(ctx),
+			appconsts.SubtreeRootThreshold(app.GetBaseApp().AppVersion()),
+		)
+		dataSquareBytes = shares.ToBytes(dataSquare)
+		size = uint64(dataSquare.Size())
+	default:
+		err = fmt.Errorf("unsupported app version: %d", app.AppVersion())
+	}
+	return dataSquareBytes, txs, size, err
+}
@@ -44,6 +44,7 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.BlobKeeper,
+		app.NamespaceKeeper,
 		app.FeeGrantKeeper,
 		app.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -138,7 +139,7 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 	)
 
 	switch app.AppVersion() {
-	case v3:
+	case v4, v3:
 		var dataSquare squarev2.Square
 		dataSquare, err = squarev2.Construct(req.BlockData.Txs, app.MaxEffectiveSquareSize(sdkCtx), subtreeRootThreshold)
 		dataSquareBytes = sharev2.ToBytes(dataSquare)
@@ -165,17 +166,11 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		return reject()
 	}
 
-	eds, err := da.ExtendShares(dataSquareBytes)
+	dah, err := app.extendSquare(req.Header, dataSquareBytes)
 	if err != nil {
 		logInvalidPropBlockError(app.Logger(), req.Header, "failure to erasure the data square", err)
 		return reject()
 	}
-
-	dah, err := da.NewDataAvailabilityHeader(eds)
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.Header, "failure to create new data availability header", err)
-		return reject()
-	}
 	// by comparing the hashes we know the computed IndexWrappers (with the share indexes of the PFB's blobs)
 	// are identical and that square layout is consistent. This also means that the share commitment rules
 	// have been followed and thus each blobs share commitment should be valid
@@ -187,6 +182,43 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 	return accept()
 }
 
+// extendSquare returns the data availability header of the data square. If the
+// data square is the one cached for the header's height and data root, which
+// is the case when this node proposed the block, the cached extended data
+// square is reused. Otherwise, the data square is extended and cached if its
+// data root matches the header's so that proofs can be served for this height
+// once it is committed.
+func (app *App) extendSquare(header tmproto.Header, dataSquareBytes [][]byte) (da.DataAvailabilityHeader, error) {
+	if eds, dah, ok := app.edsCache.Get(header.Height, header.DataHash); ok && equalShares(eds.FlattenedODS(), dataSquareBytes) {
+		return dah, nil
+	}
+
+	eds, err := app.extender.ExtendShares(dataSquareBytes)
+	if err != nil {
+		return da.DataAvailabilityHeader{}, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return da.DataAvailabilityHeader{}, err
+	}
+	if bytes.Equal(dah.Hash(), header.DataHash) {
+		app.edsCache.Add(header.Height, eds, dah)
+	}
+	return dah, nil
+}
+
+func equalShares(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func hasPFB(msgs []sdk.Msg) (*blobtypes.MsgPayForBlobs, bool) {
 	for _, msg := range msgs {
 		if pfb, ok := msg.(*blobtypes.MsgPayForBlobs); ok {
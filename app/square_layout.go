@@ -0,0 +1,117 @@
+package app
+
+import (
+	"fmt"
+
+	celestiatx "github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/blob"
+	square "github.com/celestiaorg/go-square/square"
+	squarev2 "github.com/celestiaorg/go-square/v2"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blobStartIndexFinder finds the index of the first share of a blob in a
+// square.
+type blobStartIndexFinder interface {
+	FindBlobStartingIndex(pfbIndex, blobIndex int) (int, error)
+}
+
+// SquareLayout builds the square PrepareProposal would build from the set of
+// prioritised transactions and returns its layout. Unlike PrepareProposal, the
+// transactions are not filtered by the ante handler first.
+func (app *App) SquareLayout(ctx sdk.Context, txs [][]byte) (celestiatx.SquareLayout, error) {
+	maxSquareSize := app.MaxEffectiveSquareSize(ctx)
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(app.AppVersion())
+	var (
+		finder blobStartIndexFinder
+		size   int
+		err    error
+	)
+	switch app.AppVersion() {
+	case v4, v3:
+		finder, txs, size, err = buildSquareLayoutV2(txs, maxSquareSize, subtreeRootThreshold)
+	case v2, v1:
+		finder, txs, size, err = buildSquareLayoutV1(txs, maxSquareSize, subtreeRootThreshold)
+	default:
+		err = fmt.Errorf("unsupported app version: %d", app.AppVersion())
+	}
+	if err != nil {
+		return celestiatx.SquareLayout{}, err
+	}
+
+	blobStartIndexes := make([]int, len(txs))
+	for i, tx := range txs {
+		if _, isBlob, _ := blobtx.UnmarshalBlobTx(tx); !isBlob {
+			blobStartIndexes[i] = -1
+			continue
+		}
+		blobStartIndexes[i], err = finder.FindBlobStartingIndex(i, 0)
+		if err != nil {
+			return celestiatx.SquareLayout{}, err
+		}
+	}
+
+	return celestiatx.SquareLayout{
+		Txs:              txs,
+		BlobStartIndexes: blobStartIndexes,
+		SquareSize:       uint64(size),
+		MaxSquareSize:    uint64(maxSquareSize),
+	}, nil
+}
+
+// buildSquareLayoutV2 builds a square from the transactions the same way
+// squarev2.Build does, returning the builder of the exported square along with
+// the transactions included in square order and the square size.
+func buildSquareLayoutV2(txs [][]byte, maxSquareSize, subtreeRootThreshold int) (*squarev2.Builder, [][]byte, int, error) {
+	builder, err := squarev2.NewBuilder(maxSquareSize, subtreeRootThreshold)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	normalTxs := make([][]byte, 0, len(txs))
+	blobTxs := make([][]byte, 0, len(txs))
+	for idx, rawTx := range txs {
+		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		if err != nil && isBlob {
+			return nil, nil, 0, fmt.Errorf("unmarshalling blob tx at index %d: %w", idx, err)
+		}
+		if isBlob {
+			if builder.AppendBlobTx(bTx) {
+				blobTxs = append(blobTxs, rawTx)
+			}
+		} else if builder.AppendTx(rawTx) {
+			normalTxs = append(normalTxs, rawTx)
+		}
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return builder, append(normalTxs, blobTxs...), dataSquare.Size(), nil
+}
+
+// buildSquareLayoutV1 is buildSquareLayoutV2 for the square of app versions 1
+// and 2.
+func buildSquareLayoutV1(txs [][]byte, maxSquareSize, subtreeRootThreshold int) (*square.Builder, [][]byte, int, error) {
+	builder, err := square.NewBuilder(maxSquareSize, subtreeRootThreshold)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	normalTxs := make([][]byte, 0, len(txs))
+	blobTxs := make([][]byte, 0, len(txs))
+	for _, rawTx := range txs {
+		if bTx, isBlob := blob.UnmarshalBlobTx(rawTx); isBlob {
+			if builder.AppendBlobTx(bTx) {
+				blobTxs = append(blobTxs, rawTx)
+			}
+		} else if builder.AppendTx(rawTx) {
+			normalTxs = append(normalTxs, rawTx)
+		}
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return builder, append(normalTxs, blobTxs...), dataSquare.Size(), nil
+}
@@ -3,10 +3,13 @@ package app_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
@@ -18,11 +21,14 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	"github.com/celestiaorg/go-square/v2/share"
 	"github.com/celestiaorg/go-square/v2/tx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
 	coretypes "github.com/tendermint/tendermint/types"
 )
 
@@ -48,6 +54,19 @@ func TestCheckTx(t *testing.T) {
 	}
 
 	tests := []test{
+		{
+			name:      "signal for a version that isn't supported",
+			checkType: abci.CheckTxType_New,
+			getTx: func() []byte {
+				signer := createSigner(t, kr, accs[0], encCfg.TxConfig, 1)
+				valAddr := sdk.ValAddress(signer.Account(accs[0]).Address())
+				msg := signaltypes.NewMsgSignalVersion(valAddr, appconsts.LatestVersion+1)
+				rawTx, err := signer.CreateTx([]sdk.Msg{msg}, opts...)
+				require.NoError(t, err)
+				return rawTx
+			},
+			expectedABCICode: signaltypes.ErrUnsupportedVersion.ABCICode(),
+		},
 		{
 			name:      "normal transaction, CheckTxType_New",
 			checkType: abci.CheckTxType_New,
@@ -259,3 +278,55 @@ func createSigner(t *testing.T, kr keyring.Keyring, accountName string, enc clie
 	require.NoError(t, err)
 	return signer
 }
+
+func TestCheckTxReplacement(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accs := []string{"a"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accs...)
+	testApp.Commit()
+
+	signer := createSigner(t, kr, accs[0], encCfg.TxConfig, 1)
+	addr := signer.Account(accs[0]).Address()
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	checkTx := func(sequence uint64, gasPrice float64) ([]byte, abci.ResponseCheckTx) {
+		require.NoError(t, signer.SetSequence(accs[0], sequence))
+		rawTx, err := signer.CreateTx([]sdk.Msg{msg}, user.SetGasLimitAndGasPrice(100_000, gasPrice))
+		require.NoError(t, err)
+		return rawTx, testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: rawTx})
+	}
+
+	_, resp := checkTx(0, 0.1)
+	require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+	_, resp = checkTx(1, 0.1)
+	require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+
+	// the gas price of the replacement must be high enough
+	_, resp = checkTx(0, 0.105)
+	require.Equal(t, apperr.ErrUnderpricedReplacement.ABCICode(), resp.Code, resp.Log)
+	_, resp = checkTx(0, 0.11)
+	require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+	// the replacement can be replaced as well
+	_, resp = checkTx(0, 0.12)
+	require.Equal(t, apperr.ErrUnderpricedReplacement.ABCICode(), resp.Code, resp.Log)
+	replacement, resp := checkTx(0, 0.2)
+	require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+
+	// replacing a tx doesn't affect the sequence of the later txs
+	_, resp = checkTx(2, 0.1)
+	require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+
+	// a committed tx can't be replaced
+	height := testApp.LastBlockHeight() + 1
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		Height:  height,
+		ChainID: testutil.ChainID,
+		Time:    time.Now(),
+		Version: tmversion.Consensus{App: appconsts.LatestVersion},
+	}})
+	deliverResp := testApp.DeliverTx(abci.RequestDeliverTx{Tx: replacement})
+	require.Equal(t, abci.CodeTypeOK, deliverResp.Code, deliverResp.Log)
+	testApp.EndBlock(abci.RequestEndBlock{Height: height})
+	testApp.Commit()
+	_, resp = checkTx(0, 1)
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), resp.Code, resp.Log)
+}
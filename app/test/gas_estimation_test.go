@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"math"
 	"sync"
 	"testing"
 	"time"
@@ -128,6 +129,62 @@ func TestEstimateGasPrice(t *testing.T) {
 	}
 }
 
+func TestEstimateGasPriceWithFullMempool(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping app/test/gas_estimation gas price with full mempool in short mode.")
+	}
+
+	// test setup: fill the mempool with more blobs than fit in a square
+	// before the next block is produced.
+	accountNames := testfactory.GenerateAccounts(30)
+	cfg := testnode.DefaultConfig().WithFundedAccounts(accountNames...).
+		WithTimeoutCommit(10 * time.Second) // to keep the transactions in the mempool
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	encfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	txClient, err := user.SetupTxClient(cctx.GoContext(), cctx.Keyring, cctx.GRPCClient, encfg)
+	require.NoError(t, err)
+
+	// every blob occupies around 1/20th of a square of the default max size
+	blobSize := appconsts.DefaultGovMaxSquareSize * appconsts.DefaultGovMaxSquareSize * share.ContinuationSparseShareContentSize / 20
+	gasLimit := blobtypes.DefaultEstimateGas([]uint32{uint32(blobSize)})
+	rand := tmrand.NewRand()
+	wg := &sync.WaitGroup{}
+	for i, accName := range accountNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			gasPrice := float64(i+1) * appconsts.DefaultMinGasPrice
+			blobs := blobfactory.ManyBlobs(rand, []share.Namespace{share.RandomBlobNamespace()}, []int{blobSize})
+			resp, err := txClient.BroadcastPayForBlobWithAccount(
+				cctx.GoContext(),
+				accName,
+				blobs,
+				user.SetGasLimitAndGasPrice(gasLimit, gasPrice),
+			)
+			require.NoError(t, err)
+			require.Equal(t, abci.CodeTypeOK, resp.Code, resp.RawLog)
+		}()
+	}
+	wg.Wait()
+
+	gasEstimationAPI := gasestimation.NewGasEstimatorClient(cctx.GRPCClient)
+	high, err := gasEstimationAPI.EstimateGasPrice(cctx.GoContext(), &gasestimation.EstimateGasPriceRequest{TxPriority: gasestimation.TxPriority_TX_PRIORITY_HIGH})
+	require.NoError(t, err)
+	medium, err := gasEstimationAPI.EstimateGasPrice(cctx.GoContext(), &gasestimation.EstimateGasPriceRequest{TxPriority: gasestimation.TxPriority_TX_PRIORITY_MEDIUM})
+	require.NoError(t, err)
+
+	// the high priority estimate outbids the transactions that don't fit in
+	// the next square while the mempool fits in the next two squares.
+	assert.Greater(t, high.EstimatedGasPrice, appconsts.DefaultMinGasPrice)
+	assert.Equal(t, float64(1), high.InclusionProbability)
+	assert.Equal(t, uint64(1), high.ExpectedWaitBlocks)
+	assert.Equal(t, appconsts.DefaultNetworkMinGasPrice, medium.EstimatedGasPrice)
+	assert.Equal(t, float64(0), medium.InclusionProbability)
+	assert.Equal(t, uint64(2), medium.ExpectedWaitBlocks)
+}
+
 func TestEstimateGasUsed(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping app/test/gas_estimation gas price and usage in short mode.")
@@ -189,3 +246,47 @@ func TestEstimateGasUsed(t *testing.T) {
 
 	assert.Equal(t, expectedGasEstimate, actualGasEstimate.EstimatedGasUsed)
 }
+
+func TestQuoteBlobSubmission(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping app/test/gas_estimation quote blob submission in short mode.")
+	}
+
+	cfg := testnode.DefaultConfig().WithFundedAccounts("test")
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	encfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	txClient, err := user.SetupTxClient(cctx.GoContext(), cctx.Keyring, cctx.GRPCClient, encfg)
+	require.NoError(t, err)
+
+	blobSizes := []int{100, 10 * share.ContinuationSparseShareContentSize}
+	namespace := share.RandomBlobNamespace()
+	gasEstimationAPI := gasestimation.NewGasEstimatorClient(cctx.GRPCClient)
+	quote, err := gasEstimationAPI.QuoteBlobSubmission(cctx.GoContext(), &gasestimation.QuoteBlobSubmissionRequest{
+		BlobSizes:  []uint32{uint32(blobSizes[0]), uint32(blobSizes[1])},
+		Namespaces: [][]byte{namespace.Bytes(), namespace.Bytes()},
+	})
+	require.NoError(t, err)
+
+	estimatedGas := blobtypes.DefaultEstimateGas([]uint32{uint32(blobSizes[0]), uint32(blobSizes[1])})
+	assert.Equal(t, uint64(float64(estimatedGas)*user.DefaultGasMultiplier), quote.GasLimit)
+	assert.Equal(t, []uint32{1, 11}, quote.BlobShares)
+	assert.Equal(t, appconsts.DefaultNetworkMinGasPrice, quote.NetworkMinGasPrice)
+	assert.Equal(t, uint64(math.Ceil(appconsts.DefaultNetworkMinGasPrice*float64(quote.GasLimit))), quote.MinFee)
+	require.Len(t, quote.RecommendedFees, 3)
+	assert.Equal(t, gasestimation.TxPriority_TX_PRIORITY_LOW, quote.RecommendedFees[0].TxPriority)
+	assert.Equal(t, gasestimation.TxPriority_TX_PRIORITY_MEDIUM, quote.RecommendedFees[1].TxPriority)
+	assert.Equal(t, gasestimation.TxPriority_TX_PRIORITY_HIGH, quote.RecommendedFees[2].TxPriority)
+
+	// a transaction submitted with the quoted gas limit doesn't run out of gas.
+	// The gas price is raised to the min gas price of the node since the
+	// estimate falls back to the network min gas price on an empty chain.
+	medium := quote.RecommendedFees[1]
+	assert.GreaterOrEqual(t, medium.Fee, quote.MinFee)
+	gasPrice := max(medium.GasPrice, appconsts.DefaultMinGasPrice)
+	blobs := blobfactory.ManyBlobs(tmrand.NewRand(), []share.Namespace{namespace, namespace}, blobSizes)
+	resp, err := txClient.SubmitPayForBlob(cctx.GoContext(), blobs, user.SetGasLimitAndGasPrice(quote.GasLimit, gasPrice))
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"os"
 	"testing"
 
@@ -20,14 +21,19 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/app"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	celestiablob "github.com/celestiaorg/celestia-app/v3/app/grpc/blob"
+	celestiadas "github.com/celestiaorg/celestia-app/v3/app/grpc/das"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/das"
 	"github.com/celestiaorg/celestia-app/v3/pkg/user"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
 	"github.com/celestiaorg/go-square/v2/share"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	coretypes "github.com/tendermint/tendermint/types"
 )
@@ -260,6 +266,91 @@ func (s *IntegrationTestSuite) TestShareInclusionProof() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestBlobsByNamespace() {
+	t := s.T()
+
+	txClient, err := user.SetupTxClient(s.cctx.GoContext(), s.cctx.Keyring, s.cctx.GRPCClient, s.ecfg)
+	require.NoError(t, err)
+
+	namespace := share.RandomBlobNamespace()
+	blobs := blobfactory.ManyBlobs(tmrand.NewRand(), []share.Namespace{namespace, namespace}, []int{100, 10 * kibibyte})
+	resp, err := txClient.SubmitPayForBlobWithAccount(s.cctx.GoContext(), s.accounts[140], blobs, blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+
+	blobAPI := celestiablob.NewBlobClient(s.cctx.GRPCClient)
+	res, err := blobAPI.BlobsByNamespace(s.cctx.GoContext(), &celestiablob.BlobsByNamespaceRequest{
+		Height:    resp.Height,
+		Namespace: namespace.Bytes(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, resp.Height, res.Height)
+	require.Len(t, res.Blobs, len(blobs))
+
+	blockRes, err := s.cctx.Client.Block(s.cctx.GoContext(), &resp.Height)
+	require.NoError(t, err)
+	require.Equal(t, blockRes.Block.SquareSize, res.SquareSize)
+	for i, blob := range blobs {
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.DefaultSubtreeRootThreshold)
+		require.NoError(t, err)
+		assert.Equal(t, blob.Data(), res.Blobs[i].Data)
+		assert.Equal(t, commitment, res.Blobs[i].ShareCommitment)
+
+		// the start index points to the first share of the blob in the square
+		shareRange, err := square.BlobShareRange(blockRes.Block.Txs.ToSliceOfBytes(), int(res.Blobs[i].TxIndex), i,
+			appconsts.DefaultSquareSizeUpperBound,
+			appconsts.DefaultSubtreeRootThreshold,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(shareRange.Start), res.Blobs[i].StartIndex)
+	}
+}
+
+func (s *IntegrationTestSuite) TestSampleShare() {
+	t := s.T()
+
+	txClient, err := user.SetupTxClient(s.cctx.GoContext(), s.cctx.Keyring, s.cctx.GRPCClient, s.ecfg)
+	require.NoError(t, err)
+
+	blobs := blobfactory.ManyBlobs(tmrand.NewRand(), []share.Namespace{share.RandomBlobNamespace()}, []int{10 * kibibyte})
+	resp, err := txClient.SubmitPayForBlobWithAccount(s.cctx.GoContext(), s.accounts[141], blobs, blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+
+	blockRes, err := s.cctx.Client.Block(s.cctx.GoContext(), &resp.Height)
+	require.NoError(t, err)
+	eds, err := app.ExtendBlock(blockRes.Block.Data, blockRes.Block.Header.Version.App)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	require.Equal(t, blockRes.Block.DataHash.Bytes(), dah.Hash())
+
+	dasAPI := celestiadas.NewDASClient(s.cctx.GRPCClient)
+	res, err := dasAPI.SampleShare(s.cctx.GoContext(), &celestiadas.SampleShareRequest{
+		Height: resp.Height,
+		Row:    1,
+		Col:    0,
+		Axis:   celestiadas.Axis_AXIS_COL,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resp.Height, res.Height)
+	assert.Equal(t, eds.GetCell(1, 0), res.Share)
+
+	width := uint32(len(dah.RowRoots))
+	_, err = dasAPI.SampleShare(s.cctx.GoContext(), &celestiadas.SampleShareRequest{
+		Height: resp.Height,
+		Row:    width,
+	})
+	require.Error(t, err)
+
+	getter := celestiadas.NewShareGetter(dasAPI, resp.Height)
+	sampler := das.NewSampler(getter, rand.New(rand.NewSource(1)))
+	result, err := sampler.Sample(s.cctx.GoContext(), dah, 8)
+	require.NoError(t, err)
+	assert.Len(t, result.Samples, 8)
+	assert.Equal(t, das.Confidence(len(dah.RowRoots)/2, 8), result.Confidence)
+}
+
 // ExtendBlockTest re-extends the block and compares the data roots to ensure
 // that the public functions for extending the block are working correctly.
 func ExtendBlockTest(t *testing.T, block *coretypes.Block) {
@@ -28,6 +28,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
 	"github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/share"
 	"github.com/celestiaorg/go-square/v2/tx"
@@ -368,3 +369,122 @@ func calculateNewDataHash(t *testing.T, txs [][]byte) []byte {
 	require.NoError(t, err)
 	return dah.Hash()
 }
+
+func TestProcessProposalReusesProposedSquare(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	accounts := testfactory.GenerateAccounts(2)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	blobTxs := blobfactory.ManyMultiBlobTx(
+		t, enc, kr, testutil.ChainID, accounts, infos,
+		blobfactory.NestedBlobs(
+			t,
+			testfactory.RandomBlobNamespaces(tmrand.NewRand(), 2),
+			[][]int{{1000}, {2000}},
+		),
+	)
+
+	height := testApp.LastBlockHeight() + 1
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{
+		BlockData: &tmproto.Data{Txs: blobTxs},
+		ChainId:   testutil.ChainID,
+		Height:    height,
+		Time:      time.Now(),
+	})
+	require.Len(t, resp.BlockData.Txs, len(blobTxs))
+	header := tmproto.Header{
+		Height:   height,
+		DataHash: resp.BlockData.Hash,
+		ChainID:  testutil.ChainID,
+		Version: version.Consensus{
+			App: appconsts.LatestVersion,
+		},
+	}
+
+	// the square of the proposal is the one cached by PrepareProposal
+	before := testApp.EDSCacheStats()
+	res := testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: resp.BlockData,
+		Header:    header,
+	})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Result)
+	after := testApp.EDSCacheStats()
+	assert.Equal(t, before.Hits+1, after.Hits)
+
+	// a block that claims the data root of the cached square but doesn't
+	// contain the same transactions is rejected
+	reordered := &tmproto.Data{
+		Txs:        [][]byte{resp.BlockData.Txs[1], resp.BlockData.Txs[0]},
+		SquareSize: resp.BlockData.SquareSize,
+		Hash:       resp.BlockData.Hash,
+	}
+	res = testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: reordered,
+		Header:    header,
+	})
+	assert.Equal(t, abci.ResponseProcessProposal_REJECT, res.Result)
+}
+
+func TestProcessProposalRejectsUnauthorizedNamespaceWriter(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	accounts := testfactory.GenerateAccounts(2)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+	ns := testfactory.RandomBlobNamespaces(tmrand.NewRand(), 1)[0]
+
+	blobTxs := blobfactory.ManyMultiBlobTx(
+		t, enc, kr, testutil.ChainID, accounts[:1], infos[:1],
+		blobfactory.NestedBlobs(t, []share.Namespace{ns}, [][]int{{1000}}),
+	)
+
+	height := testApp.LastBlockHeight() + 1
+	header := tmproto.Header{
+		Height:  height,
+		ChainID: testutil.ChainID,
+		Version: version.Consensus{
+			App: appconsts.LatestVersion,
+		},
+	}
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{
+		BlockData: &tmproto.Data{Txs: blobTxs},
+		ChainId:   testutil.ChainID,
+		Height:    height,
+		Time:      time.Now(),
+	})
+	require.Len(t, resp.BlockData.Txs, 1)
+	header.DataHash = resp.BlockData.Hash
+
+	// the namespace is registered by another account after the block was
+	// proposed
+	registration := namespacetypes.Registration{
+		Namespace: ns.Bytes(),
+		Owner:     testfactory.GetAddress(kr, accounts[1]).String(),
+	}
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), header, false, testApp.Logger())
+	testApp.NamespaceKeeper.SetRegistration(ctx, registration)
+
+	res := testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: resp.BlockData,
+		Header:    header,
+	})
+	assert.Equal(t, abci.ResponseProcessProposal_REJECT, res.Result)
+
+	// PrepareProposal filters out the PFB
+	filtered := testApp.PrepareProposal(abci.RequestPrepareProposal{
+		BlockData: &tmproto.Data{Txs: blobTxs},
+		ChainId:   testutil.ChainID,
+		Height:    height,
+		Time:      time.Now(),
+	})
+	assert.Empty(t, filtered.BlockData.Txs)
+
+	// the block is accepted once the signer is one of the writers
+	registration.Writers = []string{testfactory.GetAddress(kr, accounts[0]).String()}
+	testApp.NamespaceKeeper.SetRegistration(ctx, registration)
+	res = testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: resp.BlockData,
+		Header:    header,
+	})
+	assert.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Result)
+}
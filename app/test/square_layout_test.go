@@ -0,0 +1,156 @@
+package app_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	celestiatx "github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	squarev2 "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSquareLayout(t *testing.T) {
+	numBlobTxs, numNormalTxs := 3, 3
+	accnts := testfactory.GenerateAccounts(numBlobTxs + numNormalTxs)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accnts...)
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	infos := queryAccountInfo(testApp, accnts, kr)
+
+	blobTxs := blobfactory.ManyMultiBlobTx(
+		t,
+		encCfg.TxConfig,
+		kr,
+		testutil.ChainID,
+		accnts[:numBlobTxs],
+		infos[:numBlobTxs],
+		blobfactory.NestedBlobs(
+			t,
+			testfactory.RandomBlobNamespaces(tmrand.NewRand(), 4),
+			[][]int{{100}, {1000, 10}, {5000}},
+		),
+	)
+	normalTxs := testutil.SendTxsWithAccounts(
+		t,
+		testApp,
+		encCfg.TxConfig,
+		kr,
+		1000,
+		accnts[0],
+		accnts[numBlobTxs:],
+		testutil.ChainID,
+	)
+	txs := append(blobTxs, coretypes.Txs(normalTxs).ToSliceOfBytes()...)
+
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{
+		BlockData: &tmproto.Data{Txs: txs},
+		ChainId:   testutil.ChainID,
+		Height:    testApp.LastBlockHeight() + 1,
+		Time:      time.Now(),
+	})
+
+	ctx := testApp.NewContext(true, tmproto.Header{Height: testApp.LastBlockHeight()})
+	layout, err := testApp.SquareLayout(ctx, txs)
+	require.NoError(t, err)
+
+	// the layout matches the square of the proposal
+	assert.Equal(t, resp.BlockData.Txs, layout.Txs)
+	assert.Equal(t, resp.BlockData.SquareSize, layout.SquareSize)
+	assert.Equal(t, uint64(testApp.MaxEffectiveSquareSize(ctx)), layout.MaxSquareSize)
+	require.Len(t, layout.BlobStartIndexes, len(layout.Txs))
+	for i := range layout.Txs {
+		if i < numNormalTxs {
+			assert.Equal(t, -1, layout.BlobStartIndexes[i])
+			continue
+		}
+		blobRange, err := squarev2.BlobShareRange(layout.Txs, i, 0, int(layout.MaxSquareSize), appconsts.DefaultSubtreeRootThreshold)
+		require.NoError(t, err)
+		assert.Equal(t, blobRange.Start, layout.BlobStartIndexes[i])
+	}
+}
+
+func TestDryRunSquareLayout(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping app/test/square_layout dry run in short mode.")
+	}
+
+	// test setup: fill the mempool with more blobs than fit in a square
+	// before the next block is produced.
+	accountNames := testfactory.GenerateAccounts(21)
+	fillers, submitter := accountNames[:20], accountNames[20]
+	cfg := testnode.DefaultConfig().WithFundedAccounts(accountNames...).
+		WithTimeoutCommit(10 * time.Second) // to keep the transactions in the mempool
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	encfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	txClient, err := user.SetupTxClient(cctx.GoContext(), cctx.Keyring, cctx.GRPCClient, encfg)
+	require.NoError(t, err)
+
+	// every blob occupies around 1/20th of a square of the default max size
+	squareBytes := appconsts.DefaultGovMaxSquareSize * appconsts.DefaultGovMaxSquareSize * share.ContinuationSparseShareContentSize
+	blobSize := squareBytes / 20
+	gasLimit := blobtypes.DefaultEstimateGas([]uint32{uint32(blobSize)})
+	rand := tmrand.NewRand()
+	wg := &sync.WaitGroup{}
+	for i, accName := range fillers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			gasPrice := float64(i+1) * appconsts.DefaultMinGasPrice
+			blobs := blobfactory.ManyBlobs(rand, []share.Namespace{share.RandomBlobNamespace()}, []int{blobSize})
+			resp, err := txClient.BroadcastPayForBlobWithAccount(
+				cctx.GoContext(),
+				accName,
+				blobs,
+				user.SetGasLimitAndGasPrice(gasLimit, gasPrice),
+			)
+			require.NoError(t, err)
+			require.Equal(t, abci.CodeTypeOK, resp.Code, resp.RawLog)
+		}()
+	}
+	wg.Wait()
+
+	// a blob of half a square paying more than the mempool displaces the
+	// transactions paying the least
+	bigBlobSize := squareBytes / 2
+	blobs := blobfactory.ManyBlobs(rand, []share.Namespace{share.RandomBlobNamespace()}, []int{bigBlobSize})
+	blobTx, _, err := txClient.Signer().CreatePayForBlobs(
+		submitter,
+		blobs,
+		user.SetGasLimitAndGasPrice(blobtypes.DefaultEstimateGas([]uint32{uint32(bigBlobSize)}), 100*appconsts.DefaultMinGasPrice),
+	)
+	require.NoError(t, err)
+
+	txAPI := celestiatx.NewTxClient(cctx.GRPCClient)
+	resp, err := txAPI.DryRunSquareLayout(cctx.GoContext(), &celestiatx.DryRunSquareLayoutRequest{BlobTx: blobTx})
+	require.NoError(t, err)
+	assert.True(t, resp.Fits)
+	assert.NotZero(t, resp.StartShareIndex)
+	assert.Equal(t, uint64(appconsts.DefaultGovMaxSquareSize), resp.SquareSize)
+	assert.Equal(t, uint64(appconsts.DefaultGovMaxSquareSize), resp.MaxSquareSize)
+	assert.Equal(t, uint64(len(fillers)), resp.MempoolTxs)
+	assert.NotEmpty(t, resp.DisplacedTxIds)
+
+	// a transaction without blobs is rejected
+	_, err = txAPI.DryRunSquareLayout(cctx.GoContext(), &celestiatx.DryRunSquareLayoutRequest{BlobTx: []byte("not a blob tx")})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
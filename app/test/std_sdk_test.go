@@ -1,6 +1,7 @@
 package app_test
 
 import (
+	"io"
 	"sync"
 	"testing"
 	"time"
@@ -384,5 +385,33 @@ func (s *StandardSDKIntegrationTestSuite) TestGRPCQueries() {
 		})
 		require.NoError(t, err)
 		assert.Equal(t, resp.Status, "COMMITTED")
+
+		batchResp, err := txClient.TxStatusBatch(s.cctx.GoContext(), &tx.TxStatusBatchRequest{
+			TxIds: []string{res.TxHash, dummyTxHash},
+		})
+		require.NoError(t, err)
+		require.Len(t, batchResp.Statuses, 2)
+		assert.Equal(t, res.TxHash, batchResp.Statuses[0].TxId)
+		assert.Equal(t, "COMMITTED", batchResp.Statuses[0].Status.Status)
+		assert.Equal(t, res.Height, batchResp.Statuses[0].Status.Height)
+		assert.Equal(t, dummyTxHash, batchResp.Statuses[1].TxId)
+		assert.Equal(t, "UNKNOWN", batchResp.Statuses[1].Status.Status)
+
+		stream, err := txClient.SubscribeTxStatus(s.cctx.GoContext())
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&tx.SubscribeTxStatusRequest{TxIds: []string{res.TxHash}}))
+		event, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, res.TxHash, event.TxId)
+		assert.Equal(t, "COMMITTED", event.Status.Status)
+		require.NoError(t, stream.Send(&tx.SubscribeTxStatusRequest{TxIds: []string{dummyTxHash}}))
+		event, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, dummyTxHash, event.TxId)
+		assert.Equal(t, "UNKNOWN", event.Status.Status)
+		// the stream ends once the client stops sending requests
+		require.NoError(t, stream.CloseSend())
+		_, err = stream.Recv()
+		require.ErrorIs(t, err, io.EOF)
 	})
 }
@@ -11,12 +11,15 @@ import (
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
 	"github.com/celestiaorg/celestia-app/v3/pkg/user"
 	"github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/test/util/genesis"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
 	blobstreamtypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	minttypes "github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
 	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	"github.com/celestiaorg/go-square/v2/share"
 	"github.com/celestiaorg/go-square/v2/tx"
@@ -89,6 +92,11 @@ func TestAppUpgradeV3(t *testing.T) {
 		Height: 3,
 	})
 	require.Equal(t, v2.Version, endBlockResp.ConsensusParamUpdates.Version.AppVersion)
+	// the upgrade is scheduled less than app.UpgradeReadinessCheckBlocks ahead
+	// so the node checks it is ready for it right away.
+	readiness := upgradeReadinessAttributes(t, endBlockResp.Events)
+	require.Equal(t, "true", readiness[app.AttributeKeyReady], readiness[app.AttributeKeyError])
+	require.Equal(t, "3", readiness[app.AttributeKeyTargetVersion])
 	require.Equal(t, appconsts.GetTimeoutCommit(v2.Version),
 		endBlockResp.Timeouts.TimeoutCommit)
 	require.Equal(t, appconsts.GetTimeoutPropose(v2.Version),
@@ -117,6 +125,10 @@ func TestAppUpgradeV3(t *testing.T) {
 
 		require.Equal(t, appconsts.GetTimeoutCommit(appVersion), endBlockResp.Timeouts.TimeoutCommit)
 		require.Equal(t, appconsts.GetTimeoutPropose(appVersion), endBlockResp.Timeouts.TimeoutPropose)
+		// the readiness check is only performed once per upgrade
+		for _, event := range endBlockResp.Events {
+			require.NotEqual(t, app.EventTypeUpgradeReadiness, event.Type)
+		}
 
 		_ = testApp.Commit()
 	}
@@ -151,6 +163,118 @@ func TestAppUpgradeV3(t *testing.T) {
 		RequestEndBlock{Height: initialHeight + appconsts.UpgradeHeightDelay(testApp.GetChainID(), v3.Version)})
 	require.Equal(t, appconsts.GetTimeoutCommit(v3.Version), respEndBlock.Timeouts.TimeoutCommit)
 	require.Equal(t, appconsts.GetTimeoutPropose(v3.Version), respEndBlock.Timeouts.TimeoutPropose)
+	_ = testApp.Commit()
+
+	// the params of the inflation schedule are only added in v4
+	ctx = testApp.NewContext(true, tmproto.Header{})
+	require.False(t, testApp.GetSubspace(minttypes.ModuleName).Has(ctx, minttypes.KeyInitialInflationRate))
+}
+
+// TestAppUpgradeV4 verifies that the stores and the state of the modules added
+// or migrated in v4 are created during an upgrade from v3 -> v4.
+func TestAppUpgradeV4(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping TestAppUpgradeV4 in short mode")
+	}
+
+	cp := app.DefaultInitialConsensusParams()
+	cp.Version.AppVersion = v3.Version
+	testApp, genesis := setupTestApp(t, 0, cp)
+
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	validators := testApp.StakingKeeper.GetAllValidators(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	require.NoError(t, err)
+	record, err := genesis.Keyring().Key(testnode.DefaultValidatorAccountName)
+	require.NoError(t, err)
+	accAddr, err := record.GetAddress()
+	require.NoError(t, err)
+	account := testApp.AccountKeeper.GetAccount(ctx, accAddr)
+	require.False(t, testApp.GetSubspace(minttypes.ModuleName).Has(ctx, minttypes.KeyInitialInflationRate))
+	require.False(t, testApp.GetSubspace(signaltypes.ModuleName).Has(ctx, signaltypes.KeySignalExpiry))
+	// upgrades can only be cancelled from v4
+	cancelUpgrade := sdk.MsgTypeURL(&signaltypes.MsgCancelUpgrade{})
+	allowed, err := testApp.MsgGateKeeper.IsAllowed(testApp.NewContext(true, tmproto.Header{Version: tmversion.Consensus{App: v3.Version}}), cancelUpgrade)
+	require.NoError(t, err)
+	require.False(t, allowed)
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer, err := user.NewSigner(
+		genesis.Keyring(), encCfg.TxConfig, testApp.GetChainID(), v3.Version,
+		user.NewAccount(testnode.DefaultValidatorAccountName, account.GetAccountNumber(), account.GetSequence()),
+	)
+	require.NoError(t, err)
+
+	upgradeTx, err := signer.CreateTx(
+		[]sdk.Msg{
+			signaltypes.NewMsgSignalVersion(valAddr, v4.Version),
+			signaltypes.NewMsgTryUpgrade(accAddr),
+		},
+		user.SetGasLimitAndGasPrice(100_000, appconsts.DefaultMinGasPrice),
+	)
+	require.NoError(t, err)
+	testApp.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			ChainID: genesis.ChainID,
+			Height:  2,
+			Version: tmversion.Consensus{App: v3.Version},
+		},
+	})
+	deliverTxResp := testApp.DeliverTx(abci.RequestDeliverTx{Tx: upgradeTx})
+	require.Equal(t, abci.CodeTypeOK, deliverTxResp.Code, deliverTxResp.Log)
+	testApp.EndBlock(abci.RequestEndBlock{Height: 2})
+	testApp.Commit()
+
+	upgradeHeight := 2 + appconsts.UpgradeHeightDelay(testApp.GetChainID(), v3.Version)
+	for height := int64(3); height <= upgradeHeight; height++ {
+		testApp.BeginBlock(abci.RequestBeginBlock{
+			Header: tmproto.Header{
+				ChainID: genesis.ChainID,
+				Height:  height,
+				Version: tmversion.Consensus{App: v3.Version},
+			},
+		})
+		endBlockResp := testApp.EndBlock(abci.RequestEndBlock{Height: height})
+		testApp.Commit()
+		if height == upgradeHeight {
+			require.Equal(t, v4.Version, endBlockResp.ConsensusParamUpdates.Version.AppVersion)
+		}
+	}
+	require.Equal(t, v4.Version, testApp.AppVersion())
+
+	// the params of the inflation schedule are added by the migration to v4
+	ctx = testApp.NewContext(true, tmproto.Header{Version: tmversion.Consensus{App: v4.Version}})
+	require.True(t, testApp.GetSubspace(minttypes.ModuleName).Has(ctx, minttypes.KeyInitialInflationRate))
+	require.Equal(t, minttypes.DefaultParams(), testApp.MintKeeper.GetParams(ctx))
+
+	allowed, err = testApp.MsgGateKeeper.IsAllowed(ctx, cancelUpgrade)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	// the signal expiry param is added by the migration to v4
+	require.True(t, testApp.GetSubspace(signaltypes.ModuleName).Has(ctx, signaltypes.KeySignalExpiry))
+	require.Equal(t, signaltypes.DefaultSignalExpiry, testApp.SignalKeeper.SignalExpiry(ctx))
+
+	// the store of the namespace module is mounted by the upgrade and its
+	// params are initialized
+	require.Equal(t, namespacetypes.DefaultParams(), testApp.NamespaceKeeper.GetParams(ctx))
+	registration := namespacetypes.Registration{
+		Namespace: share.RandomBlobNamespace().Bytes(),
+		Owner:     accAddr.String(),
+	}
+	testApp.NamespaceKeeper.SetRegistration(ctx, registration)
+	got, found := testApp.NamespaceKeeper.GetRegistration(ctx, registration.Namespace)
+	require.True(t, found)
+	require.Equal(t, registration, got)
+}
+
+func TestCheckUpgradeReadiness(t *testing.T) {
+	testApp, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(true, tmproto.Header{Version: tmversion.Consensus{App: v4.Version}})
+
+	// the state of v4 holds the invariants of the modules of v4
+	require.NoError(t, testApp.CheckUpgradeReadiness(ctx, v4.Version, v4.Version))
+
+	err := testApp.CheckUpgradeReadiness(ctx, v4.Version, v4.Version+1)
+	require.ErrorContains(t, err, "app version 5 is not supported by this binary")
 }
 
 // TestAppUpgradeV2 verifies that the all module's params are overridden during an
@@ -250,6 +374,13 @@ func TestBlobstreamRemovedInV2(t *testing.T) {
 
 func SetupTestAppWithUpgradeHeight(t *testing.T, upgradeHeight int64) (*app.App, *genesis.Genesis) {
 	t.Helper()
+	return setupTestApp(t, upgradeHeight, app.DefaultInitialConsensusParams())
+}
+
+// setupTestApp returns an app that was initialized with the consensus params
+// cp and whose genesis block has been committed.
+func setupTestApp(t *testing.T, upgradeHeight int64, cp *tmproto.ConsensusParams) (*app.App, *genesis.Genesis) {
+	t.Helper()
 
 	db := dbm.NewMemDB()
 	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
@@ -257,18 +388,18 @@ func SetupTestAppWithUpgradeHeight(t *testing.T, upgradeHeight int64) (*app.App,
 	genesis := genesis.NewDefaultGenesis().
 		WithChainID(appconsts.TestChainID).
 		WithValidators(genesis.NewDefaultValidator(testnode.DefaultValidatorAccountName)).
-		WithConsensusParams(app.DefaultInitialConsensusParams())
+		WithConsensusParams(cp)
 	genDoc, err := genesis.Export()
 	require.NoError(t, err)
-	cp := genDoc.ConsensusParams
+	genCp := genDoc.ConsensusParams
 	abciParams := &abci.ConsensusParams{
 		Block: &abci.BlockParams{
-			MaxBytes: cp.Block.MaxBytes,
-			MaxGas:   cp.Block.MaxGas,
+			MaxBytes: genCp.Block.MaxBytes,
+			MaxGas:   genCp.Block.MaxGas,
 		},
-		Evidence:  &cp.Evidence,
-		Validator: &cp.Validator,
-		Version:   &cp.Version,
+		Evidence:  &genCp.Evidence,
+		Validator: &genCp.Validator,
+		Version:   &genCp.Version,
 	}
 
 	_ = testApp.InitChain(
@@ -283,18 +414,38 @@ func SetupTestAppWithUpgradeHeight(t *testing.T, upgradeHeight int64) (*app.App,
 
 	// assert that the chain starts with version provided in genesis
 	infoResp := testApp.Info(abci.RequestInfo{})
-	appVersion := app.DefaultInitialConsensusParams().Version.AppVersion
+	appVersion := cp.Version.AppVersion
 	require.EqualValues(t, appVersion, infoResp.AppVersion)
 	require.EqualValues(t, appconsts.GetTimeoutCommit(appVersion), infoResp.Timeouts.TimeoutCommit)
 	require.EqualValues(t, appconsts.GetTimeoutPropose(appVersion), infoResp.Timeouts.TimeoutPropose)
 
-	supportedVersions := []uint64{v1.Version, v2.Version, v3.Version}
+	supportedVersions := []uint64{v1.Version, v2.Version, v3.Version, v4.Version}
 	require.Equal(t, supportedVersions, testApp.SupportedVersions())
 
 	_ = testApp.Commit()
 	return testApp, genesis
 }
 
+// upgradeReadinessAttributes returns the attributes of the single upgrade
+// readiness event of events.
+func upgradeReadinessAttributes(t *testing.T, events []abci.Event) map[string]string {
+	t.Helper()
+	attributes := make(map[string]string)
+	found := false
+	for _, event := range events {
+		if event.Type != app.EventTypeUpgradeReadiness {
+			continue
+		}
+		require.False(t, found, "more than one upgrade readiness event")
+		found = true
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+	}
+	require.True(t, found, "no upgrade readiness event")
+	return attributes
+}
+
 func upgradeFromV1ToV2(t *testing.T, testApp *app.App) {
 	t.Helper()
 	testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
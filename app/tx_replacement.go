@@ -0,0 +1,148 @@
+package app
+
+import (
+	"sync"
+
+	apperr "github.com/celestiaorg/celestia-app/v3/app/errors"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// txPriceRetentionBlocks is the number of blocks for which the gas price of
+// a transaction accepted by CheckTx is remembered. It is longer than the
+// number of blocks a transaction stays in the mempool by default.
+const txPriceRetentionBlocks = 100
+
+// txPriceKey identifies a transaction by the address of its signer and its
+// sequence.
+type txPriceKey struct {
+	signer   string
+	sequence uint64
+}
+
+type txPrice struct {
+	gasPrice sdk.Dec
+	height   int64
+}
+
+// txPrices records the gas prices of the transactions accepted by CheckTx so
+// that a pending transaction can be replaced by a transaction with the same
+// signer and sequence and a higher gas price.
+type txPrices struct {
+	mtx      sync.Mutex
+	prices   map[txPriceKey]txPrice
+	prunedAt int64
+}
+
+func newTxPrices() *txPrices {
+	return &txPrices{prices: make(map[txPriceKey]txPrice)}
+}
+
+func (p *txPrices) get(key txPriceKey) (sdk.Dec, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	price, ok := p.prices[key]
+	return price.gasPrice, ok
+}
+
+// record sets the gas price of the transaction and, once per height, forgets
+// the prices recorded more than txPriceRetentionBlocks ago.
+func (p *txPrices) record(key txPriceKey, gasPrice sdk.Dec, height int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.prices[key] = txPrice{gasPrice: gasPrice, height: height}
+	if height <= p.prunedAt {
+		return
+	}
+	for k, price := range p.prices {
+		if height-price.height > txPriceRetentionBlocks {
+			delete(p.prices, k)
+		}
+	}
+	p.prunedAt = height
+}
+
+// checkNewTx checks a new transaction. A transaction whose sequence is
+// already used by a pending transaction of the same signer replaces it if
+// its gas price is at least appconsts.MinReplacementGasPriceBumpPercent
+// percent higher. Both remain in the mempool but, as the mempool rechecks and
+// reaps transactions by priority, the replacement is the one that is included
+// and the original fails its recheck. req.Tx must not be wrapped in a BlobTx.
+func (app *App) checkNewTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	key, gasPrice, ok := app.txPriceKey(req.Tx)
+	if !ok {
+		return app.BaseApp.CheckTx(req)
+	}
+
+	ctx := app.NewContext(true, tmproto.Header{})
+	signer := sdk.AccAddress(key.signer)
+	acc := app.AccountKeeper.GetAccount(ctx, signer)
+	if acc == nil || key.sequence >= acc.GetSequence() {
+		res := app.BaseApp.CheckTx(req)
+		if res.IsOK() {
+			app.txPrices.record(key, gasPrice, app.LastBlockHeight())
+		}
+		return res
+	}
+
+	// the sequence is used by a transaction that is either committed, which
+	// the ante handler rejects, or still pending, which can be replaced
+	committed := app.AccountKeeper.GetAccount(app.NewUncachedContext(true, tmproto.Header{}), signer)
+	prevGasPrice, pending := app.txPrices.get(key)
+	if !pending || committed == nil || key.sequence < committed.GetSequence() {
+		return app.BaseApp.CheckTx(req)
+	}
+	minGasPrice := prevGasPrice.MulInt64(100 + appconsts.MinReplacementGasPriceBumpPercent).QuoInt64(100)
+	if gasPrice.LT(minGasPrice) {
+		err := apperr.ErrUnderpricedReplacement.Wrapf("gas price %s, pending tx with sequence %d has gas price %s, minimum replacement gas price %s", gasPrice, key.sequence, prevGasPrice, minGasPrice)
+		return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false)
+	}
+
+	// check the replacement against the account as it was before the
+	// pending transaction and restore the sequence of the account afterwards
+	// so that the later transactions of the signer remain valid
+	nextSequence := acc.GetSequence()
+	if err := acc.SetSequence(key.sequence); err != nil {
+		return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false)
+	}
+	app.AccountKeeper.SetAccount(ctx, acc)
+	res := app.BaseApp.CheckTx(req)
+	acc = app.AccountKeeper.GetAccount(ctx, signer)
+	if err := acc.SetSequence(nextSequence); err != nil {
+		return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false)
+	}
+	app.AccountKeeper.SetAccount(ctx, acc)
+	if res.IsOK() {
+		app.txPrices.record(key, gasPrice, app.LastBlockHeight())
+	}
+	return res
+}
+
+// txPriceKey returns the signer and sequence of a transaction along with its
+// gas price. It returns false if the transaction doesn't have exactly one
+// signer or doesn't pay its fee in the bond denom.
+func (app *App) txPriceKey(txBytes []byte) (txPriceKey, sdk.Dec, bool) {
+	sdkTx, err := app.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return txPriceKey{}, sdk.Dec{}, false
+	}
+	sigTx, ok := sdkTx.(authsigning.Tx)
+	if !ok || sigTx.GetGas() == 0 {
+		return txPriceKey{}, sdk.Dec{}, false
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil || len(sigs) != 1 || len(sigTx.GetSigners()) != 1 {
+		return txPriceKey{}, sdk.Dec{}, false
+	}
+	fee := sigTx.GetFee().AmountOf(appconsts.BondDenom)
+	if !fee.IsPositive() {
+		return txPriceKey{}, sdk.Dec{}, false
+	}
+	gasPrice := sdk.NewDecFromInt(fee).QuoInt64(int64(sigTx.GetGas()))
+	key := txPriceKey{signer: string(sigTx.GetSigners()[0]), sequence: sigs[0].Sequence}
+	return key, gasPrice, true
+}
@@ -0,0 +1,143 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// UpgradeReadinessCheckBlocks is the number of blocks before the height of a
+// pending upgrade at which the node checks that it is ready for the upgrade.
+// It is approximately one day with 6 second blocks. If the upgrade is
+// scheduled less than UpgradeReadinessCheckBlocks ahead, the check happens at
+// the block the upgrade is scheduled at.
+const UpgradeReadinessCheckBlocks = 14_400
+
+const (
+	// EventTypeUpgradeReadiness is the type of the event emitted with the
+	// result of the upgrade readiness check.
+	EventTypeUpgradeReadiness = "upgrade_readiness"
+
+	AttributeKeyCurrentVersion = "current_version"
+	AttributeKeyTargetVersion  = "target_version"
+	AttributeKeyUpgradeHeight  = "upgrade_height"
+	AttributeKeyReady          = "ready"
+	AttributeKeyError          = "error"
+)
+
+// upgradeReadinessEvents checks that the node is ready for the pending
+// upgrade, if any, once its upgrade height is UpgradeReadinessCheckBlocks
+// away. The result is logged and returned as an event. The check is performed
+// at a single height per upgrade, which only depends on the upgrade, so that
+// every node emits the event at the same height, including after a restart.
+// It doesn't modify the state.
+func (app *App) upgradeReadinessEvents(ctx sdk.Context, currentVersion uint64) []abci.Event {
+	res, err := app.SignalKeeper.GetUpgrade(sdk.WrapSDKContext(ctx), &signaltypes.QueryGetUpgradeRequest{})
+	if err != nil || res.Upgrade == nil {
+		return nil
+	}
+	upgrade := res.Upgrade
+	if upgrade.AppVersion <= currentVersion {
+		return nil
+	}
+	// the upgrade was scheduled UpgradeHeightDelay blocks ahead, so the check
+	// happens when it is scheduled if that is less than
+	// UpgradeReadinessCheckBlocks ahead.
+	checkBlocks := min(int64(UpgradeReadinessCheckBlocks), appconsts.UpgradeHeightDelay(ctx.ChainID(), currentVersion))
+	if upgrade.UpgradeHeight-ctx.BlockHeight() != checkBlocks {
+		return nil
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyCurrentVersion, strconv.FormatUint(currentVersion, 10)),
+		sdk.NewAttribute(AttributeKeyTargetVersion, strconv.FormatUint(upgrade.AppVersion, 10)),
+		sdk.NewAttribute(AttributeKeyUpgradeHeight, strconv.FormatInt(upgrade.UpgradeHeight, 10)),
+	}
+	if err := app.CheckUpgradeReadiness(ctx, currentVersion, upgrade.AppVersion); err != nil {
+		app.Logger().Error("node is not ready for the pending upgrade", "current version", currentVersion, "target version", upgrade.AppVersion, "upgrade height", upgrade.UpgradeHeight, "err", err)
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyReady, "false"), sdk.NewAttribute(AttributeKeyError, err.Error()))
+	} else {
+		app.Logger().Info("node is ready for the pending upgrade", "current version", currentVersion, "target version", upgrade.AppVersion, "upgrade height", upgrade.UpgradeHeight)
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyReady, "true"))
+	}
+	return sdk.Events{sdk.NewEvent(EventTypeUpgradeReadiness, attributes...)}.ToABCIEvents()
+}
+
+// CheckUpgradeReadiness dry-runs the upgrade from fromVersion to toVersion on
+// a branch of the state of ctx. It returns an error if the binary doesn't
+// support toVersion, if the store or module migrations fail or if an invariant
+// of the modules of toVersion is broken after the migrations.
+func (app *App) CheckUpgradeReadiness(ctx sdk.Context, fromVersion, toVersion uint64) (err error) {
+	if !slices.Contains(app.SupportedVersions(), toVersion) {
+		return fmt.Errorf("app version %d is not supported by this binary, supported versions are %v", toVersion, app.SupportedVersions())
+	}
+	storeMigrations, err := app.migrateCommitStore(fromVersion, toVersion)
+	if err != nil {
+		return fmt.Errorf("failed to get store migrations: %w", err)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic during upgrade dry-run: %v", r)
+		}
+	}()
+
+	dryRunCtx := ctx.
+		WithMultiStore(app.upgradeDryRunStore(ctx.MultiStore(), fromVersion, storeMigrations)).
+		WithEventManager(sdk.NewEventManager())
+	if err := app.migrateModules(dryRunCtx, fromVersion, toVersion); err != nil {
+		return fmt.Errorf("failed to migrate modules: %w", err)
+	}
+
+	moduleNames := app.manager.ModuleNames(toVersion)
+	for _, route := range app.CrisisKeeper.Routes() {
+		if !slices.Contains(moduleNames, route.ModuleName) {
+			continue
+		}
+		if msg, broken := route.Invar(dryRunCtx); broken {
+			return fmt.Errorf("invariant %s is broken after the migrations: %s", route.FullRoute(), msg)
+		}
+	}
+	return nil
+}
+
+// upgradeDryRunStore returns a branch of ms with the stores of fromVersion
+// migrated as the commit store would be by storeMigrations. The stores that
+// are added start empty. Writes to the returned store are never written to ms.
+func (app *App) upgradeDryRunStore(ms sdk.MultiStore, fromVersion uint64, storeMigrations baseapp.StoreMigrations) sdk.MultiStore {
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper)
+	keys := make(map[string]storetypes.StoreKey)
+	add := func(key storetypes.StoreKey, store storetypes.CacheWrapper) {
+		stores[key] = store
+		keys[key.Name()] = key
+	}
+
+	for _, key := range app.baseKeys() {
+		add(key, ms.GetKVStore(key))
+	}
+	for _, key := range app.versionedKeys(fromVersion) {
+		if _, deleted := storeMigrations.Deleted[key.Name()]; !deleted {
+			add(key, ms.GetKVStore(key))
+		}
+	}
+	for _, key := range app.tkeys {
+		add(key, ms.GetKVStore(key))
+	}
+	for _, key := range app.memKeys {
+		add(key, ms.GetKVStore(key))
+	}
+	for _, key := range storeMigrations.Added {
+		add(key, dbadapter.Store{DB: dbm.NewMemDB()})
+	}
+	return cachemulti.NewStore(dbm.NewMemDB(), stores, keys, nil, nil)
+}
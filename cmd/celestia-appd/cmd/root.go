@@ -144,6 +144,7 @@ func addStartFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 	startCmd.Flags().Int64(UpgradeHeightFlag, 0, "Upgrade height to switch from v1 to v2. Must be coordinated amongst all validators")
 	startCmd.Flags().Duration(TimeoutCommitFlag, 0, "Override the application configured timeout_commit. Note: only for testing purposes.")
+	startCmd.Flags().Int(app.FlagEDSWorkers, 0, "Number of workers used to erasure code the data square and compute its roots. Defaults to GOMAXPROCS.")
 }
 
 // replaceLogger optionally replaces the logger with a file logger if the flag
@@ -34,3 +34,9 @@ const (
 )
 
 var DefaultUpperBoundMaxBytes = DefaultSquareSizeUpperBound * DefaultSquareSizeUpperBound * share.ContinuationSparseShareContentSize
+
+// MinReplacementGasPriceBumpPercent is the minimum percentage by which the gas
+// price of a transaction must exceed the gas price of the pending transaction
+// with the same signer and sequence for CheckTx to accept it as a
+// replacement. It is not consensus breaking.
+const MinReplacementGasPriceBumpPercent = 10
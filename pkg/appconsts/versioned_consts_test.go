@@ -9,6 +9,7 @@ import (
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
 )
 
 func TestVersionedConsts(t *testing.T) {
@@ -72,6 +73,36 @@ func TestVersionedConsts(t *testing.T) {
 			expectedConstant: v3.MaxTxSize,
 			got:              appconsts.MaxTxSize(v3.Version),
 		},
+		{
+			name:             "SubtreeRootThreshold v4",
+			version:          v4.Version,
+			expectedConstant: v4.SubtreeRootThreshold,
+			got:              appconsts.SubtreeRootThreshold(v4.Version),
+		},
+		{
+			name:             "SquareSizeUpperBound v4",
+			version:          v4.Version,
+			expectedConstant: v4.SquareSizeUpperBound,
+			got:              appconsts.SquareSizeUpperBound(v4.Version),
+		},
+		{
+			name:             "TxSizeCostPerByte v4",
+			version:          v4.Version,
+			expectedConstant: v4.TxSizeCostPerByte,
+			got:              appconsts.TxSizeCostPerByte(v4.Version),
+		},
+		{
+			name:             "GasPerBlobByte v4",
+			version:          v4.Version,
+			expectedConstant: v4.GasPerBlobByte,
+			got:              appconsts.GasPerBlobByte(v4.Version),
+		},
+		{
+			name:             "MaxTxSize v4",
+			version:          v4.Version,
+			expectedConstant: v4.MaxTxSize,
+			got:              appconsts.MaxTxSize(v4.Version),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -118,6 +149,12 @@ func TestUpgradeHeightDelay(t *testing.T) {
 			version:                    3,
 			expectedUpgradeHeightDelay: v3.UpgradeHeightDelay,
 		},
+		{
+			name:                       "v4 upgrade delay",
+			chainID:                    "mocha-4",
+			version:                    4,
+			expectedUpgradeHeightDelay: v4.UpgradeHeightDelay,
+		},
 		{
 			name:                       "the upgrade delay for chainID 'test' should be 3 regardless of the version",
 			chainID:                    appconsts.TestChainID,
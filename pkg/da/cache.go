@@ -0,0 +1,161 @@
+package da
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// DefaultCacheSize is the default maximum number of bytes of the extended data
+// squares held by a Cache. It fits the squares of a few blocks of the maximum
+// square size.
+const DefaultCacheSize = 256 << 20 // 256 MiB
+
+// Cache is a bounded cache of the extended data squares, and their data
+// availability headers, of recent heights. It holds a single square per height
+// and evicts the squares of the lowest heights first once its size exceeds its
+// maximum size. A nil Cache is valid and caches nothing. Cache is safe for
+// concurrent use.
+type Cache struct {
+	mu      sync.Mutex
+	maxSize int
+	size    int
+	entries map[int64]cacheEntry
+	hits    uint64
+	misses  uint64
+}
+
+type cacheEntry struct {
+	eds      *rsmt2d.ExtendedDataSquare
+	dah      DataAvailabilityHeader
+	dataRoot []byte
+	size     int
+}
+
+// CacheStats are the statistics of a Cache.
+type CacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+	// Size is the number of bytes of the shares held by the cache.
+	Size int
+}
+
+// HitRate returns the fraction of lookups that were served from the cache.
+func (s CacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// NewCache returns a cache holding at most maxSize bytes of shares.
+func NewCache(maxSize int) *Cache {
+	return &Cache{
+		maxSize: maxSize,
+		entries: make(map[int64]cacheEntry),
+	}
+}
+
+// Add caches the extended data square of the height along with its data
+// availability header, replacing the square previously cached for that
+// height. The roots of the square must already be computed, which is the case
+// once its data availability header is, so that the square is only read from
+// then on. Squares that only fit by evicting the squares of higher heights are
+// not cached. Only squares computed by the node from the blocks it proposes,
+// processes or stores should be added, as the cache trusts their heights and
+// data roots.
+func (c *Cache) Add(height int64, eds *rsmt2d.ExtendedDataSquare, dah DataAvailabilityHeader) {
+	if c == nil {
+		return
+	}
+	size := int(eds.Width()*eds.Width()) * share.ShareSize
+	if size > c.maxSize {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.remove(height)
+	defer c.emitMetrics()
+
+	// only the squares of lower heights are evicted to make room
+	lower := make([]int64, 0, len(c.entries))
+	freeable := 0
+	for h, entry := range c.entries {
+		if h < height {
+			lower = append(lower, h)
+			freeable += entry.size
+		}
+	}
+	if c.size-freeable+size > c.maxSize {
+		return
+	}
+	sort.Slice(lower, func(i, j int) bool { return lower[i] < lower[j] })
+	for _, h := range lower {
+		if c.size+size <= c.maxSize {
+			break
+		}
+		c.remove(h)
+	}
+	dataRoot := dah.Hash()
+	c.entries[height] = cacheEntry{eds: eds, dah: dah, dataRoot: dataRoot, size: size}
+	c.size += size
+}
+
+// Get returns the cached extended data square of the height and its data
+// availability header if its data root is dataRoot.
+func (c *Cache) Get(height int64, dataRoot []byte) (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader, bool) {
+	if c == nil {
+		return nil, DataAvailabilityHeader{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[height]
+	if !ok || !bytes.Equal(entry.dataRoot, dataRoot) {
+		c.misses++
+		telemetry.IncrCounter(1, "eds_cache", "misses")
+		c.emitMetrics()
+		return nil, DataAvailabilityHeader{}, false
+	}
+	c.hits++
+	telemetry.IncrCounter(1, "eds_cache", "hits")
+	c.emitMetrics()
+	return entry.eds, entry.dah, true
+}
+
+// Stats returns the statistics of the cache.
+func (c *Cache) Stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{
+		Hits:    c.hits,
+		Misses:  c.misses,
+		Entries: len(c.entries),
+		Size:    c.size,
+	}
+}
+
+func (c *Cache) remove(height int64) {
+	if entry, ok := c.entries[height]; ok {
+		c.size -= entry.size
+		delete(c.entries, height)
+	}
+}
+
+// emitMetrics must be called with the lock held.
+func (c *Cache) emitMetrics() {
+	stats := CacheStats{Hits: c.hits, Misses: c.misses}
+	telemetry.SetGauge(float32(stats.HitRate()), "eds_cache", "hit_rate")
+	telemetry.SetGauge(float32(c.size), "eds_cache", "size_bytes")
+	telemetry.SetGauge(float32(len(c.entries)), "eds_cache", "entries")
+}
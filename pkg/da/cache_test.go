@@ -0,0 +1,92 @@
+package da
+
+import (
+	"testing"
+
+	sh "github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	eds, dah := extendedSquare(t, 4)
+	otherEDS, otherDAH := extendedSquare(t, 16)
+	// the extended data square of a square of size 2 has 16 shares and the one
+	// of a square of size 4 has 64 shares
+	entrySize, otherEntrySize := 16*sh.ShareSize, 64*sh.ShareSize
+
+	t.Run("hit and miss", func(t *testing.T) {
+		cache := NewCache(entrySize + otherEntrySize)
+		cache.Add(1, eds, dah)
+
+		got, gotDAH, ok := cache.Get(1, dah.Hash())
+		require.True(t, ok)
+		assert.Same(t, eds, got)
+		assert.Equal(t, dah.Hash(), gotDAH.Hash())
+
+		// a different height or data root misses
+		_, _, ok = cache.Get(2, dah.Hash())
+		assert.False(t, ok)
+		_, _, ok = cache.Get(1, otherDAH.Hash())
+		assert.False(t, ok)
+
+		// a new square for a height replaces the previous one
+		cache.Add(1, otherEDS, otherDAH)
+		_, _, ok = cache.Get(1, dah.Hash())
+		assert.False(t, ok)
+		got, _, ok = cache.Get(1, otherDAH.Hash())
+		require.True(t, ok)
+		assert.Same(t, otherEDS, got)
+
+		stats := cache.Stats()
+		assert.Equal(t, CacheStats{Hits: 2, Misses: 3, Entries: 1, Size: otherEntrySize}, stats)
+		assert.InDelta(t, 0.4, stats.HitRate(), 1e-9)
+	})
+
+	t.Run("evicts the lowest heights", func(t *testing.T) {
+		cache := NewCache(3 * entrySize)
+		for height := int64(1); height <= 4; height++ {
+			cache.Add(height, eds, dah)
+		}
+		assert.Equal(t, 3, cache.Stats().Entries)
+		_, _, ok := cache.Get(1, dah.Hash())
+		assert.False(t, ok)
+		for height := int64(2); height <= 4; height++ {
+			_, _, ok := cache.Get(height, dah.Hash())
+			assert.True(t, ok, "height %d", height)
+		}
+
+		// the squares of higher heights aren't evicted for a lower height
+		cache.Add(1, eds, dah)
+		_, _, ok = cache.Get(1, dah.Hash())
+		assert.False(t, ok)
+		assert.Equal(t, 3, cache.Stats().Entries)
+	})
+
+	t.Run("square larger than the cache", func(t *testing.T) {
+		cache := NewCache(entrySize - 1)
+		cache.Add(1, eds, dah)
+		_, _, ok := cache.Get(1, dah.Hash())
+		assert.False(t, ok)
+		assert.Zero(t, cache.Stats().Size)
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		var cache *Cache
+		cache.Add(1, eds, dah)
+		_, _, ok := cache.Get(1, dah.Hash())
+		assert.False(t, ok)
+		assert.Equal(t, CacheStats{}, cache.Stats())
+	})
+}
+
+func extendedSquare(t *testing.T, count int) (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader) {
+	shares := generateShares(count)
+	shares[0][len(shares[0])-1] = byte(count)
+	eds, err := ExtendShares(shares)
+	require.NoError(t, err)
+	dah, err := NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return eds, dah
+}
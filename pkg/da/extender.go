@@ -0,0 +1,179 @@
+package da
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// Extender erasure codes data squares and computes the roots of their rows
+// and columns using a bounded pool of workers. The extended data squares, and
+// thus the data availability headers, it produces are identical to the ones
+// of ExtendShares. Extender is safe for concurrent use, in which case the
+// squares share the pool of workers.
+type Extender struct {
+	workers int
+	// sem bounds the number of row and column roots computed concurrently.
+	sem chan struct{}
+}
+
+// NewExtender returns an extender using the given number of workers. If
+// workers isn't positive, the number of workers is GOMAXPROCS.
+func NewExtender(workers int) *Extender {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return &Extender{
+		workers: workers,
+		sem:     make(chan struct{}, workers),
+	}
+}
+
+// Workers returns the number of workers of the extender.
+func (e *Extender) Workers() int {
+	return e.workers
+}
+
+// ExtendShares erasure codes the original data square made of the shares. The
+// roots of the returned extended data square are computed by the workers of
+// the extender once they are first requested, which NewDataAvailabilityHeader
+// does.
+func (e *Extender) ExtendShares(s [][]byte) (*rsmt2d.ExtendedDataSquare, error) {
+	// Check that the length of the square is a power of 2.
+	if !square.IsPowerOfTwo(len(s)) {
+		return nil, fmt.Errorf("number of shares is not a power of 2: got %d", len(s))
+	}
+	squareSize := SquareSize(len(s))
+	width := 2 * squareSize
+	codec := appconsts.DefaultCodec()
+
+	// the original data square is the first quadrant of the extended one
+	shares := make([][]byte, width*width)
+	for row := 0; row < squareSize; row++ {
+		copy(shares[row*width:row*width+squareSize], s[row*squareSize:(row+1)*squareSize])
+	}
+	extendRow := func(row int) error {
+		parity, err := codec.Encode(shares[row*width : row*width+squareSize])
+		if err != nil {
+			return err
+		}
+		copy(shares[row*width+squareSize:(row+1)*width], parity)
+		return nil
+	}
+	extendCol := func(col int) error {
+		original := make([][]byte, squareSize)
+		for row := range original {
+			original[row] = shares[row*width+col]
+		}
+		parity, err := codec.Encode(original)
+		if err != nil {
+			return err
+		}
+		for i, sh := range parity {
+			shares[(squareSize+i)*width+col] = sh
+		}
+		return nil
+	}
+
+	// extend the rows and columns of the original data square, filling the
+	// second and third quadrants.
+	err := e.forEach(2*squareSize, func(i int) error {
+		if i < squareSize {
+			return extendRow(i)
+		}
+		return extendCol(i - squareSize)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// extend the rows of the third quadrant, filling the fourth one. Extending
+	// the columns of the second quadrant would produce the same shares.
+	err = e.forEach(squareSize, func(i int) error {
+		return extendRow(squareSize + i)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rsmt2d.ImportExtendedDataSquare(shares, codec, e.newConstructor(uint64(squareSize)))
+}
+
+// forEach calls fn for every index in [0, n) using the workers of the extender
+// and returns the first error encountered.
+func (e *Extender) forEach(n int, fn func(i int) error) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	indexes := make(chan int)
+	for w := 0; w < min(e.workers, n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := fn(i); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return firstErr
+}
+
+// newConstructor returns a tree constructor creating the same trees as
+// wrapper.NewConstructor whose roots are computed by the workers of the
+// extender.
+func (e *Extender) newConstructor(squareSize uint64) rsmt2d.TreeConstructorFn {
+	return func(_ rsmt2d.Axis, axisIndex uint) rsmt2d.Tree {
+		return &boundedTree{
+			squareSize: squareSize,
+			axisIndex:  axisIndex,
+			sem:        e.sem,
+		}
+	}
+}
+
+// boundedTree buffers the shares pushed to it and only builds the NMT, which
+// is where the hashing happens, once its root is requested and a worker is
+// available.
+type boundedTree struct {
+	squareSize uint64
+	axisIndex  uint
+	sem        chan struct{}
+	shares     [][]byte
+}
+
+// Push implements the rsmt2d.Tree.Push method.
+func (t *boundedTree) Push(data []byte) error {
+	t.shares = append(t.shares, data)
+	return nil
+}
+
+// Root implements the rsmt2d.Tree.Root method.
+func (t *boundedTree) Root() ([]byte, error) {
+	t.sem <- struct{}{}
+	defer func() { <-t.sem }()
+
+	tree := wrapper.NewErasuredNamespacedMerkleTree(t.squareSize, t.axisIndex)
+	for _, sh := range t.shares {
+		if err := tree.Push(sh); err != nil {
+			return nil, err
+		}
+	}
+	return tree.Root()
+}
@@ -0,0 +1,90 @@
+package da
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"testing"
+
+	sh "github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtenderMatchesExtendShares(t *testing.T) {
+	for _, squareSize := range []int{1, 2, 4, 16, 64} {
+		shares := randomShares(t, squareSize*squareSize)
+		want, err := ExtendShares(shares)
+		require.NoError(t, err)
+		wantDAH, err := NewDataAvailabilityHeader(want)
+		require.NoError(t, err)
+
+		for _, workers := range []int{0, 1, 3, 8} {
+			t.Run(fmt.Sprintf("square size %d with %d workers", squareSize, workers), func(t *testing.T) {
+				eds, err := NewExtender(workers).ExtendShares(shares)
+				require.NoError(t, err)
+				assert.Equal(t, want.Flattened(), eds.Flattened())
+				dah, err := NewDataAvailabilityHeader(eds)
+				require.NoError(t, err)
+				assert.Equal(t, wantDAH.RowRoots, dah.RowRoots)
+				assert.Equal(t, wantDAH.ColumnRoots, dah.ColumnRoots)
+				assert.Equal(t, wantDAH.Hash(), dah.Hash())
+			})
+		}
+	}
+}
+
+func TestExtenderConcurrentSquares(t *testing.T) {
+	extender := NewExtender(2)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		shares := randomShares(t, 16*16)
+		want, err := ExtendShares(shares)
+		require.NoError(t, err)
+		wantDAH, err := NewDataAvailabilityHeader(want)
+		require.NoError(t, err)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			eds, err := extender.ExtendShares(shares)
+			if !assert.NoError(t, err) {
+				return
+			}
+			dah, err := NewDataAvailabilityHeader(eds)
+			assert.NoError(t, err)
+			assert.Equal(t, wantDAH.Hash(), dah.Hash())
+		}()
+	}
+	wg.Wait()
+}
+
+func TestExtenderErrors(t *testing.T) {
+	extender := NewExtender(2)
+	assert.Equal(t, 2, extender.Workers())
+
+	_, err := extender.ExtendShares(randomShares(t, 3))
+	assert.Error(t, err)
+
+	// shares out of namespace order can't be committed to
+	shares := randomShares(t, 4)
+	shares[0], shares[3] = shares[3], shares[0]
+	eds, err := extender.ExtendShares(shares)
+	require.NoError(t, err)
+	_, err = NewDataAvailabilityHeader(eds)
+	assert.Error(t, err)
+}
+
+// randomShares returns count shares of random data with namespaces in
+// ascending order.
+func randomShares(t *testing.T, count int) [][]byte {
+	shares := make([][]byte, count)
+	for i := range shares {
+		ns := sh.MustNewV0Namespace(append(make([]byte, sh.NamespaceVersionZeroIDSize-2), byte(i>>8), byte(i)))
+		data := make([]byte, sh.ShareSize-sh.NamespaceSize)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		shares[i] = append(ns.Bytes(), data...)
+	}
+	return shares
+}
@@ -0,0 +1,123 @@
+// Package das contains the helpers a light client uses to sample the extended
+// data square of a block and gain confidence that its data is available.
+package das
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// ErrInvalidProof is returned when a sampled share doesn't verify against the
+// data availability header.
+var ErrInvalidProof = errors.New("invalid share proof")
+
+// Coordinate identifies a share of an extended data square and the axis its
+// proof is against.
+type Coordinate struct {
+	Row  uint
+	Col  uint
+	Axis rsmt2d.Axis
+}
+
+// SampledShare is a share of an extended data square along with its NMT proof
+// to the root of the row or column of its coordinate.
+type SampledShare struct {
+	Share []byte
+	Proof *proof.NMTProof
+}
+
+// ShareGetter fetches the shares of the extended data square of a block.
+type ShareGetter interface {
+	GetShare(ctx context.Context, coord Coordinate) (SampledShare, error)
+}
+
+// ProveShare returns the share of the extended data square at the coordinate
+// along with its NMT proof.
+func ProveShare(eds *rsmt2d.ExtendedDataSquare, coord Coordinate) (SampledShare, error) {
+	if coord.Row >= eds.Width() || coord.Col >= eds.Width() {
+		return SampledShare{}, fmt.Errorf("coordinate (%d, %d) out of bounds of an extended data square of width %d", coord.Row, coord.Col, eds.Width())
+	}
+
+	var axisIndex, shareIndex uint
+	var shares [][]byte
+	switch coord.Axis {
+	case rsmt2d.Row:
+		axisIndex, shareIndex, shares = coord.Row, coord.Col, eds.Row(coord.Row)
+	case rsmt2d.Col:
+		axisIndex, shareIndex, shares = coord.Col, coord.Row, eds.Col(coord.Col)
+	default:
+		return SampledShare{}, fmt.Errorf("invalid axis %d", coord.Axis)
+	}
+
+	// we have to re-create the tree as the eds one is not accessible.
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(eds.Width()/2), axisIndex)
+	for _, sh := range shares {
+		if err := tree.Push(sh); err != nil {
+			return SampledShare{}, err
+		}
+	}
+	nmtProof, err := tree.ProveRange(int(shareIndex), int(shareIndex)+1)
+	if err != nil {
+		return SampledShare{}, err
+	}
+	return SampledShare{
+		Share: shares[shareIndex],
+		Proof: &proof.NMTProof{
+			Start:    int32(nmtProof.Start()),
+			End:      int32(nmtProof.End()),
+			Nodes:    nmtProof.Nodes(),
+			LeafHash: nmtProof.LeafHash(),
+		},
+	}, nil
+}
+
+// VerifyShare checks that the sampled share is the share at the coordinate of
+// the extended data square committed to by the data availability header.
+func VerifyShare(dah da.DataAvailabilityHeader, coord Coordinate, sampled SampledShare) error {
+	width := uint(len(dah.RowRoots))
+	if coord.Row >= width || coord.Col >= width {
+		return fmt.Errorf("coordinate (%d, %d) out of bounds of an extended data square of width %d", coord.Row, coord.Col, width)
+	}
+	if sampled.Proof == nil {
+		return fmt.Errorf("%w: empty proof", ErrInvalidProof)
+	}
+	if len(sampled.Share) != share.ShareSize {
+		return fmt.Errorf("%w: share size %d, expected %d", ErrInvalidProof, len(sampled.Share), share.ShareSize)
+	}
+
+	var root []byte
+	var shareIndex uint
+	switch coord.Axis {
+	case rsmt2d.Row:
+		root, shareIndex = dah.RowRoots[coord.Row], coord.Col
+	case rsmt2d.Col:
+		root, shareIndex = dah.ColumnRoots[coord.Col], coord.Row
+	default:
+		return fmt.Errorf("invalid axis %d", coord.Axis)
+	}
+	if sampled.Proof.Start != int32(shareIndex) || sampled.Proof.End != int32(shareIndex)+1 {
+		return fmt.Errorf("%w: proof of range [%d, %d), expected [%d, %d)", ErrInvalidProof, sampled.Proof.Start, sampled.Proof.End, shareIndex, shareIndex+1)
+	}
+
+	// the shares of the original data square are namespaced by their own
+	// namespace while the parity shares use the parity shares namespace.
+	namespace := share.ParitySharesNamespace.Bytes()
+	squareSize := width / 2
+	if coord.Row < squareSize && coord.Col < squareSize {
+		namespace = sampled.Share[:share.NamespaceSize]
+	}
+	nmtProof := nmt.NewInclusionProof(int(sampled.Proof.Start), int(sampled.Proof.End), sampled.Proof.Nodes, true)
+	if !nmtProof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, [][]byte{sampled.Share}, root) {
+		return fmt.Errorf("%w: share (%d, %d) doesn't verify against the axis root", ErrInvalidProof, coord.Row, coord.Col)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package das_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/das"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+func TestProveShare(t *testing.T) {
+	eds, dah := randomEDS(t)
+	width := eds.Width()
+
+	for _, axis := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+		for row := uint(0); row < width; row++ {
+			for col := uint(0); col < width; col++ {
+				coord := das.Coordinate{Row: row, Col: col, Axis: axis}
+				sampled, err := das.ProveShare(eds, coord)
+				require.NoError(t, err)
+				assert.Equal(t, eds.GetCell(row, col), sampled.Share)
+				assert.NoError(t, das.VerifyShare(dah, coord, sampled))
+			}
+		}
+	}
+
+	_, err := das.ProveShare(eds, das.Coordinate{Row: width, Col: 0})
+	assert.Error(t, err)
+}
+
+func TestVerifyShare(t *testing.T) {
+	eds, dah := randomEDS(t)
+	width := eds.Width()
+	// a share of the original data square and one of the parity quadrants
+	odsCoord := das.Coordinate{Row: 1, Col: 0, Axis: rsmt2d.Row}
+	parityCoord := das.Coordinate{Row: width - 1, Col: 1, Axis: rsmt2d.Col}
+
+	type testCase struct {
+		name     string
+		coord    das.Coordinate
+		malleate func(sampled *das.SampledShare)
+		wantErr  bool
+	}
+	var tests []testCase
+	for _, coord := range []das.Coordinate{odsCoord, parityCoord} {
+		tests = append(tests,
+			testCase{
+				name:     "valid share",
+				coord:    coord,
+				malleate: func(*das.SampledShare) {},
+			},
+			testCase{
+				name:  "tampered share",
+				coord: coord,
+				malleate: func(sampled *das.SampledShare) {
+					sampled.Share = bytes.Clone(sampled.Share)
+					sampled.Share[len(sampled.Share)-1] ^= 0xff
+				},
+				wantErr: true,
+			},
+			testCase{
+				name:  "truncated share",
+				coord: coord,
+				malleate: func(sampled *das.SampledShare) {
+					sampled.Share = sampled.Share[:len(sampled.Share)-1]
+				},
+				wantErr: true,
+			},
+			testCase{
+				name:  "missing proof",
+				coord: coord,
+				malleate: func(sampled *das.SampledShare) {
+					sampled.Proof = nil
+				},
+				wantErr: true,
+			},
+			testCase{
+				name:  "proof of another share",
+				coord: coord,
+				malleate: func(sampled *das.SampledShare) {
+					other, err := das.ProveShare(eds, das.Coordinate{Row: (coord.Row + 1) % width, Col: (coord.Col + 1) % width, Axis: coord.Axis})
+					require.NoError(t, err)
+					sampled.Proof = other.Proof
+				},
+				wantErr: true,
+			},
+		)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sampled, err := das.ProveShare(eds, tt.coord)
+			require.NoError(t, err)
+			tt.malleate(&sampled)
+			err = das.VerifyShare(dah, tt.coord, sampled)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, das.ErrInvalidProof)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("share at another coordinate", func(t *testing.T) {
+		sampled, err := das.ProveShare(eds, odsCoord)
+		require.NoError(t, err)
+		other := das.Coordinate{Row: odsCoord.Row + 1, Col: odsCoord.Col, Axis: odsCoord.Axis}
+		assert.Error(t, das.VerifyShare(dah, other, sampled))
+	})
+
+	t.Run("out of bounds", func(t *testing.T) {
+		sampled, err := das.ProveShare(eds, odsCoord)
+		require.NoError(t, err)
+		assert.Error(t, das.VerifyShare(dah, das.Coordinate{Row: width, Col: 0}, sampled))
+	})
+}
+
+// randomEDS returns the extended data square of a square built from random
+// transactions along with its data availability header.
+func randomEDS(t *testing.T) (*rsmt2d.ExtendedDataSquare, da.DataAvailabilityHeader) {
+	txs := testfactory.GenerateRandomTxs(20, 500)
+	dataSquare, err := square.Construct(
+		txs.ToSliceOfBytes(),
+		appconsts.DefaultSquareSizeUpperBound,
+		appconsts.DefaultSubtreeRootThreshold,
+	)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	require.Greater(t, eds.Width(), uint(2))
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return eds, dah
+}
@@ -0,0 +1,92 @@
+package das
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// Result is the result of sampling an extended data square.
+type Result struct {
+	// Samples are the coordinates of the shares that were fetched and
+	// verified.
+	Samples []Coordinate
+	// Confidence is the probability that the data of the square is available
+	// given that all the samples were.
+	Confidence float64
+}
+
+// Sampler samples the extended data squares of blocks by fetching random
+// shares from a ShareGetter and verifying them against the data availability
+// header.
+type Sampler struct {
+	getter ShareGetter
+	rand   *rand.Rand
+}
+
+// NewSampler returns a sampler fetching the shares from the getter and picking
+// the coordinates of the samples using rand.
+func NewSampler(getter ShareGetter, rand *rand.Rand) *Sampler {
+	return &Sampler{
+		getter: getter,
+		rand:   rand,
+	}
+}
+
+// Sample fetches and verifies numSamples distinct random shares of the
+// extended data square committed to by the data availability header. It
+// returns an error as soon as a share can't be fetched or doesn't verify, in
+// which case the data must be considered unavailable.
+func (s *Sampler) Sample(ctx context.Context, dah da.DataAvailabilityHeader, numSamples int) (Result, error) {
+	if err := dah.ValidateBasic(); err != nil {
+		return Result{}, err
+	}
+	width := len(dah.RowRoots)
+	if numSamples <= 0 || numSamples > width*width {
+		return Result{}, fmt.Errorf("number of samples %d must be between 1 and the number of shares %d", numSamples, width*width)
+	}
+
+	samples := make([]Coordinate, 0, numSamples)
+	for _, index := range s.rand.Perm(width * width)[:numSamples] {
+		coord := Coordinate{
+			Row: uint(index / width),
+			Col: uint(index % width),
+		}
+		// pick a random axis so that the proofs are spread over the row and
+		// column roots
+		if s.rand.Intn(2) == 1 {
+			coord.Axis = rsmt2d.Col
+		}
+		sampled, err := s.getter.GetShare(ctx, coord)
+		if err != nil {
+			return Result{}, fmt.Errorf("fetching share (%d, %d): %w", coord.Row, coord.Col, err)
+		}
+		if err := VerifyShare(dah, coord, sampled); err != nil {
+			return Result{}, err
+		}
+		samples = append(samples, coord)
+	}
+
+	return Result{
+		Samples:    samples,
+		Confidence: Confidence(width/2, numSamples),
+	}, nil
+}
+
+// Confidence returns the probability that the data of an original data square
+// of squareSize is available given that numSamples random shares of its
+// extended data square were. To make the data unrecoverable, at least
+// (squareSize+1)^2 of the (2*squareSize)^2 shares must be withheld, so every
+// sample has at least that fraction of chance of hitting a withheld share.
+// Sampling with replacement is assumed which makes this a lower bound.
+func Confidence(squareSize, numSamples int) float64 {
+	if squareSize <= 0 || numSamples <= 0 {
+		return 0
+	}
+	withheld := math.Pow(float64(squareSize+1), 2) / math.Pow(float64(2*squareSize), 2)
+	return 1 - math.Pow(1-withheld, float64(numSamples))
+}
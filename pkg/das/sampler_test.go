@@ -0,0 +1,116 @@
+package das_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/das"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// edsGetter serves the shares of an extended data square held in memory. The
+// shares for which withhold returns true are not served.
+type edsGetter struct {
+	eds      *rsmt2d.ExtendedDataSquare
+	withhold func(coord das.Coordinate) bool
+	fetched  int
+}
+
+func (g *edsGetter) GetShare(_ context.Context, coord das.Coordinate) (das.SampledShare, error) {
+	g.fetched++
+	if g.withhold != nil && g.withhold(coord) {
+		return das.SampledShare{}, errors.New("share withheld")
+	}
+	return das.ProveShare(g.eds, coord)
+}
+
+func TestSampler(t *testing.T) {
+	eds, dah := randomEDS(t)
+	squareSize := int(eds.Width() / 2)
+
+	t.Run("available square", func(t *testing.T) {
+		getter := &edsGetter{eds: eds}
+		sampler := das.NewSampler(getter, rand.New(rand.NewSource(1)))
+		res, err := sampler.Sample(context.Background(), dah, 16)
+		require.NoError(t, err)
+		assert.Len(t, res.Samples, 16)
+		assert.Equal(t, 16, getter.fetched)
+		assert.Equal(t, das.Confidence(squareSize, 16), res.Confidence)
+
+		seen := make(map[[2]uint]bool)
+		for _, coord := range res.Samples {
+			key := [2]uint{coord.Row, coord.Col}
+			assert.False(t, seen[key], "coordinate (%d, %d) sampled twice", coord.Row, coord.Col)
+			seen[key] = true
+		}
+	})
+
+	t.Run("withheld quadrant", func(t *testing.T) {
+		// withholding a quadrant plus one share makes the square
+		// unrecoverable so sampling every share has to fail.
+		getter := &edsGetter{
+			eds: eds,
+			withhold: func(coord das.Coordinate) bool {
+				return int(coord.Row) >= squareSize && int(coord.Col) >= squareSize ||
+					coord.Row == 0 && coord.Col == 0
+			},
+		}
+		sampler := das.NewSampler(getter, rand.New(rand.NewSource(1)))
+		_, err := sampler.Sample(context.Background(), dah, len(dah.RowRoots)*len(dah.RowRoots))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid share", func(t *testing.T) {
+		sampler := das.NewSampler(lyingGetter{eds: eds}, rand.New(rand.NewSource(1)))
+		_, err := sampler.Sample(context.Background(), dah, 1)
+		assert.ErrorIs(t, err, das.ErrInvalidProof)
+	})
+
+	t.Run("invalid number of samples", func(t *testing.T) {
+		sampler := das.NewSampler(&edsGetter{eds: eds}, rand.New(rand.NewSource(1)))
+		_, err := sampler.Sample(context.Background(), dah, 0)
+		assert.Error(t, err)
+		_, err = sampler.Sample(context.Background(), dah, len(dah.RowRoots)*len(dah.RowRoots)+1)
+		assert.Error(t, err)
+	})
+}
+
+// lyingGetter serves the proofs of the requested shares along with altered
+// shares.
+type lyingGetter struct {
+	eds *rsmt2d.ExtendedDataSquare
+}
+
+func (g lyingGetter) GetShare(_ context.Context, coord das.Coordinate) (das.SampledShare, error) {
+	sampled, err := das.ProveShare(g.eds, coord)
+	if err != nil {
+		return das.SampledShare{}, err
+	}
+	sampled.Share = append([]byte{}, sampled.Share...)
+	sampled.Share[len(sampled.Share)-1] ^= 0xff
+	return sampled, nil
+}
+
+func TestConfidence(t *testing.T) {
+	// a square of size 1 can't be recovered from a single share
+	assert.Equal(t, 1.0, das.Confidence(1, 1))
+	assert.Zero(t, das.Confidence(0, 16))
+	assert.Zero(t, das.Confidence(64, 0))
+
+	// every sample of a large square has at least a 1/4 chance of hitting a
+	// withheld share
+	assert.InDelta(t, 1-0.75*0.75, das.Confidence(1<<20, 2), 1e-6)
+	assert.Greater(t, das.Confidence(128, 16), 0.99)
+
+	previous := 0.0
+	for numSamples := 1; numSamples <= 32; numSamples++ {
+		confidence := das.Confidence(128, numSamples)
+		assert.Greater(t, confidence, previous)
+		previous = confidence
+	}
+}
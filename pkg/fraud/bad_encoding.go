@@ -0,0 +1,164 @@
+// Package fraud contains the fraud proofs that allow a light client to reject
+// a block without downloading its whole extended data square.
+package fraud
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/das"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// ErrInvalidFraudProof is returned when a fraud proof doesn't prove that the
+// extended data square committed to by a data availability header is
+// invalid.
+var ErrInvalidFraudProof = errors.New("invalid fraud proof")
+
+// BadEncodingProof proves that a row or column of an extended data square
+// isn't the Reed-Solomon extension of its first half. It contains the shares
+// of the first half of the axis, each proven against the root of the opposite
+// axis, from which the verifier recomputes the extension and its root.
+type BadEncodingProof struct {
+	// Axis is the axis of the badly encoded row or column.
+	Axis rsmt2d.Axis
+	// Index is the index of the badly encoded row or column.
+	Index uint
+	// Shares are the shares of the first half of the axis. The proof of the
+	// share at position i is against the root of the i-th column if Axis is
+	// a row and against the root of the i-th row otherwise.
+	Shares []das.SampledShare
+}
+
+// NewBadEncodingProof returns the bad encoding proof of the row or column at
+// index of the extended data square. It doesn't check that the axis is
+// actually badly encoded.
+func NewBadEncodingProof(eds *rsmt2d.ExtendedDataSquare, axis rsmt2d.Axis, index uint) (BadEncodingProof, error) {
+	if index >= eds.Width() {
+		return BadEncodingProof{}, fmt.Errorf("index %d out of bounds of an extended data square of width %d", index, eds.Width())
+	}
+	squareSize := eds.Width() / 2
+	shares := make([]das.SampledShare, squareSize)
+	for i := uint(0); i < squareSize; i++ {
+		sampled, err := das.ProveShare(eds, shareCoordinate(axis, index, i))
+		if err != nil {
+			return BadEncodingProof{}, err
+		}
+		shares[i] = sampled
+	}
+	return BadEncodingProof{
+		Axis:   axis,
+		Index:  index,
+		Shares: shares,
+	}, nil
+}
+
+// FindBadEncoding checks that every row and column of the extended data square
+// is the extension of its first half and commits to the corresponding root of
+// the data availability header. It returns the proof of the first badly
+// encoded axis found or nil if the square is correctly encoded.
+func FindBadEncoding(eds *rsmt2d.ExtendedDataSquare, dah da.DataAvailabilityHeader) (*BadEncodingProof, error) {
+	if err := dah.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if uint(len(dah.RowRoots)) != eds.Width() {
+		return nil, fmt.Errorf("data availability header of width %d doesn't match the extended data square of width %d", len(dah.RowRoots), eds.Width())
+	}
+
+	squareSize := eds.Width() / 2
+	for _, axis := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+		for index := uint(0); index < eds.Width(); index++ {
+			shares := eds.Row(index)
+			if axis == rsmt2d.Col {
+				shares = eds.Col(index)
+			}
+			root, err := extendedRoot(squareSize, index, shares[:squareSize])
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(root, axisRoot(dah, axis, index)) {
+				continue
+			}
+			befp, err := NewBadEncodingProof(eds, axis, index)
+			if err != nil {
+				return nil, err
+			}
+			return &befp, nil
+		}
+	}
+	return nil, nil
+}
+
+// Verify returns nil if the proof shows that the extended data square
+// committed to by the data availability header is badly encoded.
+func (p BadEncodingProof) Verify(dah da.DataAvailabilityHeader) error {
+	if err := dah.ValidateBasic(); err != nil {
+		return err
+	}
+	width := uint(len(dah.RowRoots))
+	if p.Axis != rsmt2d.Row && p.Axis != rsmt2d.Col {
+		return fmt.Errorf("%w: invalid axis %d", ErrInvalidFraudProof, p.Axis)
+	}
+	if p.Index >= width {
+		return fmt.Errorf("%w: index %d out of bounds of an extended data square of width %d", ErrInvalidFraudProof, p.Index, width)
+	}
+	squareSize := width / 2
+	if uint(len(p.Shares)) != squareSize {
+		return fmt.Errorf("%w: got %d shares, expected %d", ErrInvalidFraudProof, len(p.Shares), squareSize)
+	}
+
+	shares := make([][]byte, squareSize)
+	for i, sampled := range p.Shares {
+		// the shares are proven against the roots of the opposite axis
+		coord := shareCoordinate(p.Axis, p.Index, uint(i))
+		if err := das.VerifyShare(dah, coord, sampled); err != nil {
+			return fmt.Errorf("%w: share %d: %v", ErrInvalidFraudProof, i, err)
+		}
+		shares[i] = sampled.Share
+	}
+
+	root, err := extendedRoot(squareSize, p.Index, shares)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(root, axisRoot(dah, p.Axis, p.Index)) {
+		return fmt.Errorf("%w: axis %d is correctly encoded", ErrInvalidFraudProof, p.Index)
+	}
+	return nil
+}
+
+// shareCoordinate returns the coordinate of the share at position i of the
+// row or column at index, proven against the root of the opposite axis.
+func shareCoordinate(axis rsmt2d.Axis, index, i uint) das.Coordinate {
+	if axis == rsmt2d.Row {
+		return das.Coordinate{Row: index, Col: i, Axis: rsmt2d.Col}
+	}
+	return das.Coordinate{Row: i, Col: index, Axis: rsmt2d.Row}
+}
+
+func axisRoot(dah da.DataAvailabilityHeader, axis rsmt2d.Axis, index uint) []byte {
+	if axis == rsmt2d.Row {
+		return dah.RowRoots[index]
+	}
+	return dah.ColumnRoots[index]
+}
+
+// extendedRoot extends the first half of a row or column and returns the NMT
+// root of the resulting axis.
+func extendedRoot(squareSize, index uint, shares [][]byte) ([]byte, error) {
+	parity, err := appconsts.DefaultCodec().Encode(shares)
+	if err != nil {
+		return nil, err
+	}
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), index)
+	for _, sh := range append(append([][]byte{}, shares...), parity...) {
+		if err := tree.Push(sh); err != nil {
+			return nil, err
+		}
+	}
+	return tree.Root()
+}
@@ -0,0 +1,104 @@
+package fraud_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/das"
+	"github.com/celestiaorg/celestia-app/v3/pkg/fraud"
+	"github.com/celestiaorg/celestia-app/v3/test/util/malicious"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+func TestFindBadEncoding(t *testing.T) {
+	shares := randomShares(t)
+	squareSize := uint(square.Size(len(shares)))
+	honestEDS, err := da.ExtendShares(shares)
+	require.NoError(t, err)
+	honestDAH, err := da.NewDataAvailabilityHeader(honestEDS)
+	require.NoError(t, err)
+
+	t.Run("correctly encoded square", func(t *testing.T) {
+		befp, err := fraud.FindBadEncoding(honestEDS, honestDAH)
+		require.NoError(t, err)
+		assert.Nil(t, befp)
+
+		for _, axis := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+			befp, err := fraud.NewBadEncodingProof(honestEDS, axis, 1)
+			require.NoError(t, err)
+			assert.ErrorIs(t, befp.Verify(honestDAH), fraud.ErrInvalidFraudProof)
+		}
+	})
+
+	type testCase struct {
+		name     string
+		row, col uint
+		wantAxis rsmt2d.Axis
+	}
+	tests := []testCase{
+		{"original share", 0, 1, rsmt2d.Row},
+		{"row parity share", 1, squareSize, rsmt2d.Row},
+		{"column parity share", squareSize + 1, 0, rsmt2d.Row},
+		{"parity of parity share", 2*squareSize - 1, 2*squareSize - 1, rsmt2d.Row},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eds, err := malicious.ExtendSharesWithBadEncoding(shares, tt.row, tt.col)
+			require.NoError(t, err)
+			dah, err := da.NewDataAvailabilityHeader(eds)
+			require.NoError(t, err)
+
+			befp, err := fraud.FindBadEncoding(eds, dah)
+			require.NoError(t, err)
+			require.NotNil(t, befp)
+			assert.Equal(t, tt.wantAxis, befp.Axis)
+			assert.Equal(t, tt.row, befp.Index)
+			assert.Len(t, befp.Shares, int(squareSize))
+			assert.NoError(t, befp.Verify(dah))
+
+			// the column of the corrupted share is badly encoded too
+			colProof, err := fraud.NewBadEncodingProof(eds, rsmt2d.Col, tt.col)
+			require.NoError(t, err)
+			assert.NoError(t, colProof.Verify(dah))
+
+			// the proof doesn't hold against the honest square
+			assert.ErrorIs(t, befp.Verify(honestDAH), fraud.ErrInvalidFraudProof)
+
+			// a proof of another row doesn't prove anything
+			otherRow := (tt.row + 1) % eds.Width()
+			otherProof, err := fraud.NewBadEncodingProof(eds, rsmt2d.Row, otherRow)
+			require.NoError(t, err)
+			assert.ErrorIs(t, otherProof.Verify(dah), fraud.ErrInvalidFraudProof)
+
+			// the shares must be proven against the data availability header
+			tampered := *befp
+			tampered.Shares = append([]das.SampledShare{}, befp.Shares...)
+			tampered.Shares[0], tampered.Shares[1] = tampered.Shares[1], tampered.Shares[0]
+			assert.ErrorIs(t, tampered.Verify(dah), fraud.ErrInvalidFraudProof)
+
+			tampered.Shares = befp.Shares[1:]
+			assert.ErrorIs(t, tampered.Verify(dah), fraud.ErrInvalidFraudProof)
+		})
+	}
+}
+
+// randomShares returns the shares of a square built from random
+// transactions.
+func randomShares(t *testing.T) [][]byte {
+	txs := testfactory.GenerateRandomTxs(20, 500)
+	dataSquare, err := square.Construct(
+		txs.ToSliceOfBytes(),
+		appconsts.DefaultSquareSizeUpperBound,
+		appconsts.DefaultSubtreeRootThreshold,
+	)
+	require.NoError(t, err)
+	require.Greater(t, dataSquare.Size(), 2)
+	return share.ToBytes(dataSquare)
+}
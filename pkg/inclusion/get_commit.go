@@ -10,13 +10,32 @@ import (
 // GetCommitment gets the share commitment for a blob in the original data
 // square.
 func GetCommitment(cacher *EDSSubTreeRootCacher, dah da.DataAvailabilityHeader, start, blobShareLen, subtreeRootThreshold int) ([]byte, error) {
+	rowSubTreeRoots, err := GetSubTreeRoots(cacher, dah, start, blobShareLen, subtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	subTreeRoots := make([][]byte, 0)
+	for _, roots := range rowSubTreeRoots {
+		subTreeRoots = append(subTreeRoots, roots...)
+	}
+	return merkle.HashFromByteSlices(subTreeRoots), nil
+}
+
+// GetSubTreeRoots gets the subtree roots used to create the share commitment
+// of a blob in the original data square. The subtree roots are grouped by row,
+// the first group being the subtree roots of the first row of the blob.
+func GetSubTreeRoots(cacher *EDSSubTreeRootCacher, dah da.DataAvailabilityHeader, start, blobShareLen, subtreeRootThreshold int) ([][][]byte, error) {
 	squareSize := len(dah.RowRoots) / 2
 	if start+blobShareLen > squareSize*squareSize {
 		return nil, errors.New("cannot get commitment for blob that doesn't fit in square")
 	}
 	paths := calculateCommitmentPaths(squareSize, start, blobShareLen, subtreeRootThreshold)
-	subTreeRoots := make([][]byte, len(paths))
-	for i, path := range paths {
+	if len(paths) == 0 {
+		return [][][]byte{}, nil
+	}
+	startRow := paths[0].row
+	rowSubTreeRoots := make([][][]byte, paths[len(paths)-1].row-startRow+1)
+	for _, path := range paths {
 		// here we prepend false (walk left down the tree) because we only need
 		// the subtree roots from the original data square.
 		originalSquarePath := append(append(make([]WalkInstruction, 0, len(path.instructions)+1), WalkLeft), path.instructions...)
@@ -24,7 +43,7 @@ func GetCommitment(cacher *EDSSubTreeRootCacher, dah da.DataAvailabilityHeader,
 		if err != nil {
 			return nil, err
 		}
-		subTreeRoots[i] = subTreeRoot
+		rowSubTreeRoots[path.row-startRow] = append(rowSubTreeRoots[path.row-startRow], subTreeRoot)
 	}
-	return merkle.HashFromByteSlices(subTreeRoots), nil
+	return rowSubTreeRoots, nil
 }
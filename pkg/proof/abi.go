@@ -0,0 +1,440 @@
+package proof
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// The types below mirror the structs of the Blobstream DA verifier contracts so
+// that the proofs can be ABI encoded and verified on-chain. The data root tuple
+// and the binary Merkle proof reuse the bindings of the Blobstream contract.
+
+// ABINamespace is the namespace of the DA verifier contracts.
+type ABINamespace struct {
+	Version [1]byte
+	ID      [share.NamespaceIDSize]byte `abi:"id"`
+}
+
+// ABINamespaceNode is a node of a namespaced Merkle tree in the DA verifier
+// contracts.
+type ABINamespaceNode struct {
+	Min    ABINamespace
+	Max    ABINamespace
+	Digest [32]byte
+}
+
+// ABINamespaceMerkleMultiproof is an NMT range proof in the DA verifier
+// contracts.
+type ABINamespaceMerkleMultiproof struct {
+	BeginKey  *big.Int
+	EndKey    *big.Int
+	SideNodes []ABINamespaceNode
+}
+
+// ABIAttestationProof is a proof that a data root tuple was committed to by the
+// data root tuple root at the given nonce in the Blobstream contract.
+type ABIAttestationProof struct {
+	TupleRootNonce *big.Int
+	Tuple          wrapper.DataRootTuple
+	Proof          wrapper.BinaryMerkleProof
+}
+
+// ABISharesProof is a proof that a set of shares were committed to by the
+// Blobstream contract, as verified by the DA verifier contracts.
+type ABISharesProof struct {
+	Data             [][]byte
+	ShareProofs      []ABINamespaceMerkleMultiproof
+	Namespace        ABINamespace
+	RowRoots         []ABINamespaceNode
+	RowProofs        []wrapper.BinaryMerkleProof
+	AttestationProof ABIAttestationProof
+}
+
+var (
+	namespaceABIComponents = []abi.ArgumentMarshaling{
+		{Name: "version", Type: "bytes1"},
+		{Name: "id", Type: "bytes28"},
+	}
+	namespaceNodeABIComponents = []abi.ArgumentMarshaling{
+		{Name: "min", Type: "tuple", Components: namespaceABIComponents},
+		{Name: "max", Type: "tuple", Components: namespaceABIComponents},
+		{Name: "digest", Type: "bytes32"},
+	}
+	namespaceMerkleMultiproofABIComponents = []abi.ArgumentMarshaling{
+		{Name: "beginKey", Type: "uint256"},
+		{Name: "endKey", Type: "uint256"},
+		{Name: "sideNodes", Type: "tuple[]", Components: namespaceNodeABIComponents},
+	}
+	binaryMerkleProofABIComponents = []abi.ArgumentMarshaling{
+		{Name: "sideNodes", Type: "bytes32[]"},
+		{Name: "key", Type: "uint256"},
+		{Name: "numLeaves", Type: "uint256"},
+	}
+	dataRootTupleABIComponents = []abi.ArgumentMarshaling{
+		{Name: "height", Type: "uint256"},
+		{Name: "dataRoot", Type: "bytes32"},
+	}
+	attestationProofABIComponents = []abi.ArgumentMarshaling{
+		{Name: "tupleRootNonce", Type: "uint256"},
+		{Name: "tuple", Type: "tuple", Components: dataRootTupleABIComponents},
+		{Name: "proof", Type: "tuple", Components: binaryMerkleProofABIComponents},
+	}
+	sharesProofABIComponents = []abi.ArgumentMarshaling{
+		{Name: "data", Type: "bytes[]"},
+		{Name: "shareProofs", Type: "tuple[]", Components: namespaceMerkleMultiproofABIComponents},
+		{Name: "namespace", Type: "tuple", Components: namespaceABIComponents},
+		{Name: "rowRoots", Type: "tuple[]", Components: namespaceNodeABIComponents},
+		{Name: "rowProofs", Type: "tuple[]", Components: binaryMerkleProofABIComponents},
+		{Name: "attestationProof", Type: "tuple", Components: attestationProofABIComponents},
+	}
+
+	// SharesProofABIArguments are the arguments of the ABI encoding of a
+	// SharesProof struct.
+	SharesProofABIArguments = abi.Arguments{{Name: "sharesProof", Type: mustNewABIType("tuple", sharesProofABIComponents)}}
+	// RowProofABIArguments are the arguments of the ABI encoding of a row
+	// proof, i.e. the row roots and their binary Merkle proofs to the data
+	// root, as taken by the DA verifier contracts.
+	RowProofABIArguments = abi.Arguments{
+		{Name: "rowRoots", Type: mustNewABIType("tuple[]", namespaceNodeABIComponents)},
+		{Name: "rowProofs", Type: mustNewABIType("tuple[]", binaryMerkleProofABIComponents)},
+	}
+	// AttestationProofABIArguments are the arguments of the ABI encoding of an
+	// AttestationProof struct.
+	AttestationProofABIArguments = abi.Arguments{{Name: "attestationProof", Type: mustNewABIType("tuple", attestationProofABIComponents)}}
+)
+
+func mustNewABIType(t string, components []abi.ArgumentMarshaling) abi.Type {
+	abiType, err := abi.NewType(t, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return abiType
+}
+
+// NewABIAttestationProof returns the proof that the data root of the block at
+// height was committed to by the data root tuple root at nonce. The proof is
+// the one returned by the DataRootInclusionProof endpoint of celestia-core.
+func NewABIAttestationProof(nonce uint64, height int64, dataRoot []byte, proof merkle.Proof) (ABIAttestationProof, error) {
+	if height < 0 {
+		return ABIAttestationProof{}, fmt.Errorf("height %d cannot be negative", height)
+	}
+	if len(dataRoot) != 32 {
+		return ABIAttestationProof{}, fmt.Errorf("data root must be 32 bytes, got %d", len(dataRoot))
+	}
+	binaryProof, err := toABIBinaryMerkleProof(proof.Aunts, proof.Index, proof.Total)
+	if err != nil {
+		return ABIAttestationProof{}, err
+	}
+	return ABIAttestationProof{
+		TupleRootNonce: new(big.Int).SetUint64(nonce),
+		Tuple: wrapper.DataRootTuple{
+			Height:   big.NewInt(height),
+			DataRoot: *(*[32]byte)(dataRoot),
+		},
+		Proof: binaryProof,
+	}, nil
+}
+
+// Verify checks that the data root tuple of the proof was committed to by the
+// data root tuple root. It is the same check the Blobstream contract runs in
+// verifyAttestation, minus the lookup of the root by nonce.
+func (ap ABIAttestationProof) Verify(dataRootTupleRoot []byte) error {
+	if ap.Tuple.Height == nil || ap.Proof.Key == nil || ap.Proof.NumLeaves == nil {
+		return errors.New("incomplete attestation proof")
+	}
+	// the leaves of the data root tuple root are the ABI encoded tuples
+	leaf := make([]byte, 64)
+	ap.Tuple.Height.FillBytes(leaf[:32])
+	copy(leaf[32:], ap.Tuple.DataRoot[:])
+	proof, err := fromABIBinaryMerkleProof(ap.Proof, leaf)
+	if err != nil {
+		return err
+	}
+	return proof.Verify(dataRootTupleRoot, leaf)
+}
+
+// EncodeAttestationProofABI returns the ABI encoding of the attestation proof.
+func EncodeAttestationProofABI(ap ABIAttestationProof) ([]byte, error) {
+	return AttestationProofABIArguments.Pack(ap)
+}
+
+// DecodeAttestationProofABI decodes an ABI encoded attestation proof.
+func DecodeAttestationProofABI(bz []byte) (ABIAttestationProof, error) {
+	var ap ABIAttestationProof
+	if err := unpackABI(AttestationProofABIArguments, bz, &ap); err != nil {
+		return ABIAttestationProof{}, err
+	}
+	return ap, nil
+}
+
+// ToABI converts the share proof to the SharesProof struct of the DA verifier
+// contracts, using the attestation proof of the data root of its block.
+func (sp ShareProof) ToABI(attestation ABIAttestationProof) (ABISharesProof, error) {
+	if sp.RowProof == nil {
+		return ABISharesProof{}, errors.New("empty row proof")
+	}
+	if sp.NamespaceVersion > math.MaxUint8 {
+		return ABISharesProof{}, fmt.Errorf("invalid namespace version %d", sp.NamespaceVersion)
+	}
+	namespace, err := toABINamespace(append([]byte{uint8(sp.NamespaceVersion)}, sp.NamespaceId...))
+	if err != nil {
+		return ABISharesProof{}, err
+	}
+	shareProofs := make([]ABINamespaceMerkleMultiproof, len(sp.ShareProofs))
+	for i, proof := range sp.ShareProofs {
+		sideNodes, err := toABINamespaceNodes(proof.Nodes)
+		if err != nil {
+			return ABISharesProof{}, err
+		}
+		shareProofs[i] = ABINamespaceMerkleMultiproof{
+			BeginKey:  big.NewInt(int64(proof.Start)),
+			EndKey:    big.NewInt(int64(proof.End)),
+			SideNodes: sideNodes,
+		}
+	}
+	rowRoots, rowProofs, err := sp.RowProof.ToABI()
+	if err != nil {
+		return ABISharesProof{}, err
+	}
+	return ABISharesProof{
+		Data:             sp.Data,
+		ShareProofs:      shareProofs,
+		Namespace:        namespace,
+		RowRoots:         rowRoots,
+		RowProofs:        rowProofs,
+		AttestationProof: attestation,
+	}, nil
+}
+
+// ShareProofFromABI converts a SharesProof struct of the DA verifier contracts
+// to a share proof and the attestation proof of the data root of its block.
+func ShareProofFromABI(p ABISharesProof) (ShareProof, ABIAttestationProof, error) {
+	rowProof, err := rowProofFromABI(p.RowRoots, p.RowProofs)
+	if err != nil {
+		return ShareProof{}, ABIAttestationProof{}, err
+	}
+	shareProofs := make([]*NMTProof, len(p.ShareProofs))
+	for i, proof := range p.ShareProofs {
+		if proof.BeginKey == nil || proof.EndKey == nil || !proof.BeginKey.IsInt64() || !proof.EndKey.IsInt64() ||
+			proof.BeginKey.Int64() > math.MaxInt32 || proof.EndKey.Int64() > math.MaxInt32 {
+			return ShareProof{}, ABIAttestationProof{}, fmt.Errorf("invalid range of share proof %d", i)
+		}
+		shareProofs[i] = &NMTProof{
+			Start: int32(proof.BeginKey.Int64()),
+			End:   int32(proof.EndKey.Int64()),
+			Nodes: fromABINamespaceNodes(proof.SideNodes),
+		}
+	}
+	return ShareProof{
+		Data:             p.Data,
+		ShareProofs:      shareProofs,
+		NamespaceId:      p.Namespace.ID[:],
+		RowProof:         &rowProof,
+		NamespaceVersion: uint32(p.Namespace.Version[0]),
+	}, p.AttestationProof, nil
+}
+
+// EncodeShareProofABI returns the ABI encoding of the share proof as a
+// SharesProof struct of the DA verifier contracts.
+func EncodeShareProofABI(sp ShareProof, attestation ABIAttestationProof) ([]byte, error) {
+	p, err := sp.ToABI(attestation)
+	if err != nil {
+		return nil, err
+	}
+	return SharesProofABIArguments.Pack(p)
+}
+
+// DecodeShareProofABI decodes an ABI encoded SharesProof struct of the DA
+// verifier contracts.
+func DecodeShareProofABI(bz []byte) (ShareProof, ABIAttestationProof, error) {
+	var p ABISharesProof
+	if err := unpackABI(SharesProofABIArguments, bz, &p); err != nil {
+		return ShareProof{}, ABIAttestationProof{}, err
+	}
+	return ShareProofFromABI(p)
+}
+
+// ToABI converts the row proof to the row roots and binary Merkle proofs taken
+// by the DA verifier contracts.
+func (rp RowProof) ToABI() ([]ABINamespaceNode, []wrapper.BinaryMerkleProof, error) {
+	if len(rp.Proofs) != len(rp.RowRoots) {
+		return nil, nil, fmt.Errorf("the number of proofs %d must equal the number of row roots %d", len(rp.Proofs), len(rp.RowRoots))
+	}
+	rowRoots, err := toABINamespaceNodes(rp.RowRoots)
+	if err != nil {
+		return nil, nil, err
+	}
+	rowProofs := make([]wrapper.BinaryMerkleProof, len(rp.Proofs))
+	for i, proof := range rp.Proofs {
+		rowProofs[i], err = toABIBinaryMerkleProof(proof.Aunts, proof.Index, proof.Total)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rowRoots, rowProofs, nil
+}
+
+// EncodeRowProofABI returns the ABI encoding of the row proof.
+func EncodeRowProofABI(rp RowProof) ([]byte, error) {
+	rowRoots, rowProofs, err := rp.ToABI()
+	if err != nil {
+		return nil, err
+	}
+	return RowProofABIArguments.Pack(rowRoots, rowProofs)
+}
+
+// DecodeRowProofABI decodes an ABI encoded row proof.
+func DecodeRowProofABI(bz []byte) (RowProof, error) {
+	values, err := RowProofABIArguments.Unpack(bz)
+	if err != nil {
+		return RowProof{}, err
+	}
+	var rowRoots []ABINamespaceNode
+	var rowProofs []wrapper.BinaryMerkleProof
+	if err := convertABI(values[0], &rowRoots); err != nil {
+		return RowProof{}, err
+	}
+	if err := convertABI(values[1], &rowProofs); err != nil {
+		return RowProof{}, err
+	}
+	return rowProofFromABI(rowRoots, rowProofs)
+}
+
+func rowProofFromABI(rowRoots []ABINamespaceNode, rowProofs []wrapper.BinaryMerkleProof) (RowProof, error) {
+	if len(rowRoots) == 0 || len(rowRoots) != len(rowProofs) {
+		return RowProof{}, fmt.Errorf("the number of proofs %d must equal the number of row roots %d", len(rowProofs), len(rowRoots))
+	}
+	roots := fromABINamespaceNodes(rowRoots)
+	proofs := make([]*Proof, len(rowProofs))
+	for i, rowProof := range rowProofs {
+		proof, err := fromABIBinaryMerkleProof(rowProof, roots[i])
+		if err != nil {
+			return RowProof{}, err
+		}
+		proofs[i] = &Proof{
+			Total:    proof.Total,
+			Index:    proof.Index,
+			LeafHash: proof.LeafHash,
+			Aunts:    proof.Aunts,
+		}
+	}
+	return RowProof{
+		RowRoots: roots,
+		Proofs:   proofs,
+		StartRow: uint32(proofs[0].Index),
+		EndRow:   uint32(proofs[len(proofs)-1].Index),
+	}, nil
+}
+
+func toABINamespace(ns []byte) (ABINamespace, error) {
+	if len(ns) != share.NamespaceSize {
+		return ABINamespace{}, fmt.Errorf("namespace must be %d bytes, got %d", share.NamespaceSize, len(ns))
+	}
+	var namespace ABINamespace
+	namespace.Version[0] = ns[0]
+	copy(namespace.ID[:], ns[1:])
+	return namespace, nil
+}
+
+// toABINamespaceNodes splits the NMT nodes, made of the minimum namespace, the
+// maximum namespace and the digest, into their ABI representation.
+func toABINamespaceNodes(nodes [][]byte) ([]ABINamespaceNode, error) {
+	abiNodes := make([]ABINamespaceNode, len(nodes))
+	for i, node := range nodes {
+		if len(node) != 2*share.NamespaceSize+32 {
+			return nil, fmt.Errorf("namespace node must be %d bytes, got %d", 2*share.NamespaceSize+32, len(node))
+		}
+		minNamespace, err := toABINamespace(node[:share.NamespaceSize])
+		if err != nil {
+			return nil, err
+		}
+		maxNamespace, err := toABINamespace(node[share.NamespaceSize : 2*share.NamespaceSize])
+		if err != nil {
+			return nil, err
+		}
+		abiNodes[i] = ABINamespaceNode{
+			Min:    minNamespace,
+			Max:    maxNamespace,
+			Digest: *(*[32]byte)(node[2*share.NamespaceSize:]),
+		}
+	}
+	return abiNodes, nil
+}
+
+func fromABINamespaceNodes(abiNodes []ABINamespaceNode) [][]byte {
+	nodes := make([][]byte, len(abiNodes))
+	for i, node := range abiNodes {
+		nodes[i] = make([]byte, 0, 2*share.NamespaceSize+32)
+		nodes[i] = append(append(nodes[i], node.Min.Version[:]...), node.Min.ID[:]...)
+		nodes[i] = append(append(nodes[i], node.Max.Version[:]...), node.Max.ID[:]...)
+		nodes[i] = append(nodes[i], node.Digest[:]...)
+	}
+	return nodes
+}
+
+func toABIBinaryMerkleProof(aunts [][]byte, index, total int64) (wrapper.BinaryMerkleProof, error) {
+	sideNodes := make([][32]byte, len(aunts))
+	for i, aunt := range aunts {
+		if len(aunt) != 32 {
+			return wrapper.BinaryMerkleProof{}, fmt.Errorf("side node must be 32 bytes, got %d", len(aunt))
+		}
+		sideNodes[i] = *(*[32]byte)(aunt)
+	}
+	return wrapper.BinaryMerkleProof{
+		SideNodes: sideNodes,
+		Key:       big.NewInt(index),
+		NumLeaves: big.NewInt(total),
+	}, nil
+}
+
+// fromABIBinaryMerkleProof converts the binary Merkle proof of the leaf to a
+// merkle.Proof. The leaf hash isn't part of the ABI representation so it is
+// computed from the leaf.
+func fromABIBinaryMerkleProof(p wrapper.BinaryMerkleProof, leaf []byte) (merkle.Proof, error) {
+	if p.Key == nil || p.NumLeaves == nil || !p.Key.IsInt64() || !p.NumLeaves.IsInt64() {
+		return merkle.Proof{}, errors.New("invalid binary Merkle proof key or number of leaves")
+	}
+	aunts := make([][]byte, len(p.SideNodes))
+	for i, sideNode := range p.SideNodes {
+		aunts[i] = append([]byte{}, sideNode[:]...)
+	}
+	return merkle.Proof{
+		Total:    p.NumLeaves.Int64(),
+		Index:    p.Key.Int64(),
+		LeafHash: tmhash.Sum(append([]byte{0}, leaf...)),
+		Aunts:    aunts,
+	}, nil
+}
+
+// unpackABI decodes the ABI encoding of a single struct argument into v.
+func unpackABI(args abi.Arguments, bz []byte, v interface{}) error {
+	values, err := args.Unpack(bz)
+	if err != nil {
+		return err
+	}
+	if len(values) != 1 {
+		return fmt.Errorf("expected 1 value, got %d", len(values))
+	}
+	return convertABI(values[0], v)
+}
+
+// convertABI converts a value decoded by the abi package, which is typed with
+// anonymous structs, to v.
+func convertABI(value interface{}, v interface{}) (err error) {
+	// abi.ConvertType panics if the value can't be converted
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("converting ABI value: %v", r)
+		}
+	}()
+	abi.ConvertType(value, v)
+	return nil
+}
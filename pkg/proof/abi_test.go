@@ -0,0 +1,188 @@
+package proof_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/rpc/core"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+)
+
+var updateFixtures = flag.Bool("update-fixtures", false, "regenerate the ABI fixture vectors in testdata")
+
+// abiFixture are the ABI encoded proofs of a deterministic block, committed to
+// testdata so that they can be cross-checked by the on-chain verifiers.
+type abiFixture struct {
+	DataRoot          string `json:"data_root"`
+	DataRootTupleRoot string `json:"data_root_tuple_root"`
+	ShareProof        string `json:"share_proof"`
+	RowProof          string `json:"row_proof"`
+	AttestationProof  string `json:"attestation_proof"`
+}
+
+func TestABIFixtures(t *testing.T) {
+	shareProof, dataRoot := deterministicShareProof(t)
+	attestationProof, tupleRoot := attestationProofForDataRoot(t, dataRoot)
+
+	encodedShareProof, err := proof.EncodeShareProofABI(shareProof, attestationProof)
+	require.NoError(t, err)
+	encodedRowProof, err := proof.EncodeRowProofABI(*shareProof.RowProof)
+	require.NoError(t, err)
+	encodedAttestationProof, err := proof.EncodeAttestationProofABI(attestationProof)
+	require.NoError(t, err)
+	got := abiFixture{
+		DataRoot:          hex.EncodeToString(dataRoot),
+		DataRootTupleRoot: hex.EncodeToString(tupleRoot),
+		ShareProof:        hex.EncodeToString(encodedShareProof),
+		RowProof:          hex.EncodeToString(encodedRowProof),
+		AttestationProof:  hex.EncodeToString(encodedAttestationProof),
+	}
+
+	path := filepath.Join("testdata", "abi_fixtures.json")
+	if *updateFixtures {
+		raw, err := json.MarshalIndent(got, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, append(raw, '\n'), 0o600))
+	}
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var want abiFixture
+	require.NoError(t, json.Unmarshal(raw, &want))
+	assert.Equal(t, want, got)
+
+	// the fixture vectors decode to valid proofs
+	fixtureDataRoot, err := hex.DecodeString(want.DataRoot)
+	require.NoError(t, err)
+	fixtureTupleRoot, err := hex.DecodeString(want.DataRootTupleRoot)
+	require.NoError(t, err)
+
+	rawShareProof, err := hex.DecodeString(want.ShareProof)
+	require.NoError(t, err)
+	decodedShareProof, decodedAttestationProof, err := proof.DecodeShareProofABI(rawShareProof)
+	require.NoError(t, err)
+	assert.NoError(t, decodedShareProof.Validate(fixtureDataRoot))
+	assert.NoError(t, decodedAttestationProof.Verify(fixtureTupleRoot))
+
+	rawRowProof, err := hex.DecodeString(want.RowProof)
+	require.NoError(t, err)
+	decodedRowProof, err := proof.DecodeRowProofABI(rawRowProof)
+	require.NoError(t, err)
+	assert.NoError(t, decodedRowProof.Validate(fixtureDataRoot))
+
+	rawAttestationProof, err := hex.DecodeString(want.AttestationProof)
+	require.NoError(t, err)
+	decodedAttestationProof, err = proof.DecodeAttestationProofABI(rawAttestationProof)
+	require.NoError(t, err)
+	assert.NoError(t, decodedAttestationProof.Verify(fixtureTupleRoot))
+}
+
+func TestShareProofABIRoundTrip(t *testing.T) {
+	shareProof, dataRoot := deterministicShareProof(t)
+	attestationProof, tupleRoot := attestationProofForDataRoot(t, dataRoot)
+
+	encoded, err := proof.EncodeShareProofABI(shareProof, attestationProof)
+	require.NoError(t, err)
+	decoded, decodedAttestationProof, err := proof.DecodeShareProofABI(encoded)
+	require.NoError(t, err)
+
+	assert.Equal(t, shareProof.Data, decoded.Data)
+	assert.Equal(t, shareProof.NamespaceId, decoded.NamespaceId)
+	assert.Equal(t, shareProof.NamespaceVersion, decoded.NamespaceVersion)
+	assert.Equal(t, shareProof.ShareProofs, decoded.ShareProofs)
+	assert.Equal(t, shareProof.RowProof.RowRoots, decoded.RowProof.RowRoots)
+	assert.Equal(t, shareProof.RowProof.Proofs, decoded.RowProof.Proofs)
+	assert.Equal(t, shareProof.RowProof.StartRow, decoded.RowProof.StartRow)
+	assert.Equal(t, shareProof.RowProof.EndRow, decoded.RowProof.EndRow)
+	assert.Equal(t, attestationProof, decodedAttestationProof)
+	assert.NoError(t, decoded.Validate(dataRoot))
+	assert.NoError(t, decodedAttestationProof.Verify(tupleRoot))
+
+	_, _, err = proof.DecodeShareProofABI(encoded[:len(encoded)-1])
+	assert.Error(t, err)
+}
+
+// TestAttestationProofABIMatchesContract checks that the encoding of the
+// attestation proof matches the arguments of the verifyAttestation method of
+// the Blobstream contract.
+func TestAttestationProofABIMatchesContract(t *testing.T) {
+	attestationProof, tupleRoot := attestationProofForDataRoot(t, bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, attestationProof.Verify(tupleRoot))
+
+	contractABI, err := wrapper.WrappersMetaData.GetAbi()
+	require.NoError(t, err)
+	packed, err := contractABI.Pack("verifyAttestation", attestationProof.TupleRootNonce, attestationProof.Tuple, attestationProof.Proof)
+	require.NoError(t, err)
+	encoded, err := proof.EncodeAttestationProofABI(attestationProof)
+	require.NoError(t, err)
+	// the encoding of the struct starts with the offset of its content while
+	// the call data starts with the method selector.
+	assert.Equal(t, packed[4:], encoded[32:])
+
+	// a different tuple root fails to verify
+	assert.Error(t, attestationProof.Verify(bytes.Repeat([]byte{2}, 32)))
+}
+
+// deterministicShareProof returns the share proof of a blob spanning multiple
+// rows in a block built from fixed transactions, along with its data root.
+func deterministicShareProof(t *testing.T) (proof.ShareProof, []byte) {
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	blob, err := share.NewBlob(namespace, bytes.Repeat([]byte{0xab}, 5000), share.ShareVersionZero, nil)
+	require.NoError(t, err)
+	// the square builder doesn't validate the sdk transactions
+	blobTx, err := blobtx.MarshalBlobTx([]byte("pay for blob"), blob)
+	require.NoError(t, err)
+	txs := [][]byte{bytes.Repeat([]byte{0xcd}, 1000), blobTx}
+
+	maxSquareSize := appconsts.SquareSizeUpperBound(appconsts.LatestVersion)
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	dataSquare, err := square.Construct(txs, maxSquareSize, subtreeRootThreshold)
+	require.NoError(t, err)
+	blobRange, err := square.BlobShareRange(txs, 1, 0, maxSquareSize, subtreeRootThreshold)
+	require.NoError(t, err)
+
+	shareProof, err := proof.NewShareInclusionProof(dataSquare, namespace, blobRange)
+	require.NoError(t, err)
+	require.Greater(t, len(shareProof.ShareProofs), 1)
+
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return shareProof, dah.Hash()
+}
+
+// attestationProofForDataRoot commits to the data root as the third of four
+// data root tuples and returns its attestation proof along with the data root
+// tuple root.
+func attestationProofForDataRoot(t *testing.T, dataRoot []byte) (proof.ABIAttestationProof, []byte) {
+	const nonce, height = 2, 3
+	tuples := make([][]byte, 4)
+	for i := range tuples {
+		root := bytes.Repeat([]byte{byte(i)}, 32)
+		if i+1 == height {
+			root = dataRoot
+		}
+		tuple, err := core.EncodeDataRootTuple(uint64(i+1), *(*[32]byte)(root))
+		require.NoError(t, err)
+		tuples[i] = tuple
+	}
+	tupleRoot, proofs := merkle.ProofsFromByteSlices(tuples)
+	attestationProof, err := proof.NewABIAttestationProof(nonce, height, dataRoot, *proofs[height-1])
+	require.NoError(t, err)
+	return attestationProof, tupleRoot
+}
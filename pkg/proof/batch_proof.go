@@ -0,0 +1,239 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// NewBatchShareProof takes an ODS, extends it, then returns a proof of the
+// inclusion of a set of share ranges to the data root. Each range must only
+// contain shares of a single namespace.
+func NewBatchShareProof(dataSquare square.Square, shareRanges []share.Range) (BatchShareProof, error) {
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return BatchShareProof{}, err
+	}
+	return NewBatchShareProofFromEDS(eds, shareRanges)
+}
+
+// NewBatchShareProofFromEDS takes an extended data square and returns a proof
+// of the inclusion of a set of share ranges to the data root. Each range must
+// only contain shares of a single namespace.
+func NewBatchShareProofFromEDS(eds *rsmt2d.ExtendedDataSquare, shareRanges []share.Range) (BatchShareProof, error) {
+	if len(shareRanges) == 0 {
+		return BatchShareProof{}, errors.New("no share range to prove")
+	}
+	squareSize := int(eds.Width() / 2)
+	odsShares, err := share.FromBytes(eds.FlattenedODS())
+	if err != nil {
+		return BatchShareProof{}, err
+	}
+
+	// validate the ranges and collect the rows they span
+	namespaces := make([]share.Namespace, len(shareRanges))
+	rowSet := make(map[int]struct{})
+	for i, shareRange := range shareRanges {
+		namespaces[i], err = ParseNamespace(odsShares, shareRange.Start, shareRange.End)
+		if err != nil {
+			return BatchShareProof{}, fmt.Errorf("share range %d: %w", i, err)
+		}
+		for row := shareRange.Start / squareSize; row <= (shareRange.End-1)/squareSize; row++ {
+			rowSet[row] = struct{}{}
+		}
+	}
+	rows := make([]int, 0, len(rowSet))
+	for row := range rowSet {
+		rows = append(rows, row)
+	}
+	sort.Ints(rows)
+
+	edsRowRoots, err := eds.RowRoots()
+	if err != nil {
+		return BatchShareProof{}, err
+	}
+	edsColRoots, err := eds.ColRoots()
+	if err != nil {
+		return BatchShareProof{}, err
+	}
+
+	// create the binary merkle multiproof of the row roots to the data root
+	rowRootsProof, err := NewMultiProof(append(edsRowRoots, edsColRoots...), rows)
+	if err != nil {
+		return BatchShareProof{}, err
+	}
+	protoRows := make([]uint32, len(rows))
+	rowRoots := make([][]byte, len(rows))
+	trees := make(map[int]*wrapper.ErasuredNamespacedMerkleTree, len(rows))
+	for i, row := range rows {
+		protoRows[i] = uint32(row)
+		rowRoots[i] = edsRowRoots[row]
+		trees[row], err = newRowTree(eds, squareSize, row, edsRowRoots[row])
+		if err != nil {
+			return BatchShareProof{}, err
+		}
+	}
+
+	rangeProofs := make([]*ShareRangeProof, len(shareRanges))
+	for i, shareRange := range shareRanges {
+		shareProofs := make([]*NMTProof, 0)
+		for row := shareRange.Start / squareSize; row <= (shareRange.End-1)/squareSize; row++ {
+			startLeaf, endLeaf := rowLeafRange(squareSize, row, shareRange.Start, shareRange.End)
+			proof, err := trees[row].ProveRange(startLeaf, endLeaf)
+			if err != nil {
+				return BatchShareProof{}, err
+			}
+			shareProofs = append(shareProofs, &NMTProof{
+				Start:    int32(proof.Start()),
+				End:      int32(proof.End()),
+				Nodes:    proof.Nodes(),
+				LeafHash: proof.LeafHash(),
+			})
+		}
+		rangeProofs[i] = &ShareRangeProof{
+			Start:            uint32(shareRange.Start),
+			End:              uint32(shareRange.End),
+			NamespaceId:      namespaces[i].ID(),
+			NamespaceVersion: uint32(namespaces[i].Version()),
+			Data:             share.ToBytes(odsShares[shareRange.Start:shareRange.End]),
+			ShareProofs:      shareProofs,
+		}
+	}
+
+	return BatchShareProof{
+		Rows:          protoRows,
+		RowRoots:      rowRoots,
+		RowRootsProof: &rowRootsProof,
+		ShareRanges:   rangeProofs,
+	}, nil
+}
+
+// newRowTree re-creates the tree of a row of the extended data square, as the
+// eds one is not accessible, and makes sure its root is the row root.
+func newRowTree(eds *rsmt2d.ExtendedDataSquare, squareSize, row int, rowRoot []byte) (*wrapper.ErasuredNamespacedMerkleTree, error) {
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(row))
+	for _, sh := range eds.Row(uint(row)) {
+		if err := tree.Push(sh); err != nil {
+			return nil, err
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(rowRoot, root) {
+		return nil, errors.New("eds row root is different than tree root")
+	}
+	return &tree, nil
+}
+
+// rowLeafRange returns the range of leaves of the row spanned by the share
+// range [start, end) of the original data square.
+func rowLeafRange(squareSize, row, start, end int) (int, int) {
+	startLeaf, endLeaf := 0, squareSize
+	if row == start/squareSize {
+		startLeaf = start % squareSize
+	}
+	if row == (end-1)/squareSize {
+		endLeaf = (end-1)%squareSize + 1
+	}
+	return startLeaf, endLeaf
+}
+
+// Verify checks that the share ranges of the proof exist in the block with the
+// given data root. It returns nil if the proof is valid. Otherwise, it returns
+// a sensible error.
+func (bp BatchShareProof) Verify(dataRoot []byte) error {
+	if len(bp.ShareRanges) == 0 {
+		return errors.New("empty batch share proof")
+	}
+	if bp.RowRootsProof == nil {
+		return errors.New("missing row roots proof")
+	}
+	if len(bp.Rows) != len(bp.RowRoots) {
+		return fmt.Errorf("the number of rows %d must equal the number of row roots %d", len(bp.Rows), len(bp.RowRoots))
+	}
+	// the data root commits to the row and column roots of the extended data
+	// square, so there are four times as many roots as rows in the original
+	// data square.
+	if bp.RowRootsProof.Total%4 != 0 || bp.RowRootsProof.Total > 4*int64(appconsts.SquareSizeUpperBound(appconsts.LatestVersion)) {
+		return fmt.Errorf("invalid number of roots %d", bp.RowRootsProof.Total)
+	}
+	squareSize := int(bp.RowRootsProof.Total / 4)
+
+	rows := make([]int, len(bp.Rows))
+	rowRoots := make(map[int][]byte, len(bp.Rows))
+	for i, row := range bp.Rows {
+		if int(row) >= squareSize {
+			return fmt.Errorf("row %d is not a row of the original data square of size %d", row, squareSize)
+		}
+		rows[i] = int(row)
+		rowRoots[int(row)] = bp.RowRoots[i]
+	}
+	if err := bp.RowRootsProof.Verify(dataRoot, rows, bp.RowRoots); err != nil {
+		return err
+	}
+
+	for i, rangeProof := range bp.ShareRanges {
+		if rangeProof == nil {
+			return fmt.Errorf("share range %d: empty proof", i)
+		}
+		if err := rangeProof.verify(squareSize, rowRoots); err != nil {
+			return fmt.Errorf("share range %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// verify checks that the shares of the range exist in the rows with the given
+// row roots.
+func (rp ShareRangeProof) verify(squareSize int, rowRoots map[int][]byte) error {
+	start, end := int(rp.Start), int(rp.End)
+	if end <= start || end > squareSize*squareSize {
+		return fmt.Errorf("invalid share range [%d, %d) for a square of size %d", start, end, squareSize)
+	}
+	if len(rp.Data) != end-start {
+		return fmt.Errorf("the number of shares %d must equal the share range %d", len(rp.Data), end-start)
+	}
+	startRow, endRow := start/squareSize, (end-1)/squareSize
+	if len(rp.ShareProofs) != endRow-startRow+1 {
+		return fmt.Errorf("the number of share proofs %d must equal the number of rows %d", len(rp.ShareProofs), endRow-startRow+1)
+	}
+	if rp.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("invalid namespace version %d", rp.NamespaceVersion)
+	}
+	namespace := append([]byte{uint8(rp.NamespaceVersion)}, rp.NamespaceId...)
+
+	cursor := 0
+	for i, proof := range rp.ShareProofs {
+		row := startRow + i
+		rowRoot, ok := rowRoots[row]
+		if !ok {
+			return fmt.Errorf("missing row root of row %d", row)
+		}
+		if proof == nil {
+			return fmt.Errorf("missing share proof of row %d", row)
+		}
+		// the proof must be of the leaves of the row spanned by the range
+		startLeaf, endLeaf := rowLeafRange(squareSize, row, start, end)
+		if int(proof.Start) != startLeaf || int(proof.End) != endLeaf {
+			return fmt.Errorf("share proof of row %d is of range [%d, %d), expected [%d, %d)", row, proof.Start, proof.End, startLeaf, endLeaf)
+		}
+		nmtProof := nmt.NewInclusionProof(startLeaf, endLeaf, proof.Nodes, true)
+		if !nmtProof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, rp.Data[cursor:cursor+endLeaf-startLeaf], rowRoot) {
+			return fmt.Errorf("share proof of row %d failed to verify", row)
+		}
+		cursor += endLeaf - startLeaf
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package proof_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+func TestNewBatchShareProof(t *testing.T) {
+	dataSquare, blobRanges := blobSquare(t, []int{500, 5000, 30000, 500})
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	tests := []struct {
+		name      string
+		ranges    []share.Range
+		expectErr bool
+	}{
+		{
+			name:   "single blob",
+			ranges: blobRanges[:1],
+		},
+		{
+			name:   "all blobs",
+			ranges: blobRanges,
+		},
+		{
+			name:   "blobs and transactions",
+			ranges: append([]share.Range{share.NewRange(0, 1)}, blobRanges...),
+		},
+		{
+			name:   "overlapping ranges",
+			ranges: []share.Range{blobRanges[2], share.NewRange(blobRanges[2].Start+1, blobRanges[2].End-1)},
+		},
+		{
+			name:      "no range",
+			ranges:    []share.Range{},
+			expectErr: true,
+		},
+		{
+			name:      "range of multiple namespaces",
+			ranges:    []share.Range{share.NewRange(blobRanges[0].Start, blobRanges[1].End)},
+			expectErr: true,
+		},
+		{
+			name:      "range out of the square",
+			ranges:    []share.Range{share.NewRange(0, len(dataSquare)+1)},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batchProof, err := proof.NewBatchShareProof(dataSquare, tt.ranges)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, batchProof.Verify(dataRoot))
+
+			// the proof survives a protobuf round trip
+			rawProof, err := batchProof.Marshal()
+			require.NoError(t, err)
+			var decoded proof.BatchShareProof
+			require.NoError(t, decoded.Unmarshal(rawProof))
+			assert.NoError(t, decoded.Verify(dataRoot))
+
+			for i, shareRange := range tt.ranges {
+				assert.Equal(t, share.ToBytes(dataSquare[shareRange.Start:shareRange.End]), batchProof.ShareRanges[i].Data)
+			}
+		})
+	}
+
+	t.Run("row roots are deduplicated", func(t *testing.T) {
+		batchProof, err := proof.NewBatchShareProof(dataSquare, blobRanges)
+		require.NoError(t, err)
+		rows := make(map[uint32]bool)
+		for _, row := range batchProof.Rows {
+			assert.False(t, rows[row], "row %d included more than once", row)
+			rows[row] = true
+		}
+	})
+
+	t.Run("tampered proofs are rejected", func(t *testing.T) {
+		batchProof, err := proof.NewBatchShareProof(dataSquare, blobRanges)
+		require.NoError(t, err)
+		assert.Error(t, batchProof.Verify(tmrand.Bytes(32)))
+
+		shifted, err := proof.NewBatchShareProof(dataSquare, blobRanges)
+		require.NoError(t, err)
+		shifted.ShareRanges[0].Start++
+		shifted.ShareRanges[0].End++
+		assert.Error(t, shifted.Verify(dataRoot))
+
+		tamperedShare, err := proof.NewBatchShareProof(dataSquare, blobRanges)
+		require.NoError(t, err)
+		data := tamperedShare.ShareRanges[1].Data[0]
+		data[len(data)-1] ^= 0xFF
+		assert.Error(t, tamperedShare.Verify(dataRoot))
+
+		tamperedRowRoot, err := proof.NewBatchShareProof(dataSquare, blobRanges)
+		require.NoError(t, err)
+		tamperedRowRoot.RowRoots[0] = tmrand.Bytes(len(tamperedRowRoot.RowRoots[0]))
+		assert.Error(t, tamperedRowRoot.Verify(dataRoot))
+
+		missingRow, err := proof.NewBatchShareProof(dataSquare, blobRanges)
+		require.NoError(t, err)
+		missingRow.Rows = missingRow.Rows[1:]
+		missingRow.RowRoots = missingRow.RowRoots[1:]
+		assert.Error(t, missingRow.Verify(dataRoot))
+	})
+}
+
+// BenchmarkBatchShareProofSize compares the size of a batch share proof of the
+// blobs of a block to the size of a share proof per blob.
+func BenchmarkBatchShareProofSize(b *testing.B) {
+	for _, numBlobs := range []int{1, 8, 32, 128} {
+		b.Run(fmt.Sprintf("%d blobs", numBlobs), func(b *testing.B) {
+			sizes := make([]int, numBlobs)
+			for i := range sizes {
+				sizes[i] = 2000
+			}
+			dataSquare, blobRanges := blobSquare(b, sizes)
+			eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+			require.NoError(b, err)
+
+			separateSize := 0
+			for _, blobRange := range blobRanges {
+				namespace, err := proof.ParseNamespace(dataSquare, blobRange.Start, blobRange.End)
+				require.NoError(b, err)
+				shareProof, err := proof.NewShareInclusionProofFromEDS(eds, namespace, blobRange)
+				require.NoError(b, err)
+				separateSize += shareProof.Size()
+			}
+
+			b.ResetTimer()
+			var batchProof proof.BatchShareProof
+			for i := 0; i < b.N; i++ {
+				batchProof, err = proof.NewBatchShareProofFromEDS(eds, blobRanges)
+				require.NoError(b, err)
+			}
+			b.StopTimer()
+
+			b.ReportMetric(float64(batchProof.Size()), "batch_proof_bytes")
+			b.ReportMetric(float64(separateSize), "share_proofs_bytes")
+		})
+	}
+}
+
+// blobSquare builds a data square of blob transactions with blobs of the
+// given sizes and returns it along with the share range of every blob.
+func blobSquare(t testing.TB, sizes []int) (square.Square, []share.Range) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	namespaces := testfactory.RandomBlobNamespaces(tmrand.NewRand(), len(sizes))
+	txs := coretypes.Txs(blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, namespaces, sizes)).ToSliceOfBytes()
+
+	maxSquareSize := appconsts.SquareSizeUpperBound(appconsts.LatestVersion)
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	dataSquare, err := square.Construct(txs, maxSquareSize, subtreeRootThreshold)
+	require.NoError(t, err)
+	blobRanges := make([]share.Range, len(txs))
+	for i := range txs {
+		blobRanges[i], err = square.BlobShareRange(txs, i, 0, maxSquareSize, subtreeRootThreshold)
+		require.NoError(t, err)
+	}
+	return dataSquare, blobRanges
+}
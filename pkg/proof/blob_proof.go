@@ -0,0 +1,181 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/inclusion"
+	"github.com/celestiaorg/go-square/v2"
+	squareinclusion "github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// NewBlobProof locates the blob of the namespace with the share commitment in
+// the square built from the transactions and returns a proof of its inclusion
+// to the data root. The proof contains the share proof of the shares of the
+// blob and the subtree roots linking the share commitment to the row roots.
+func NewBlobProof(txs [][]byte, namespace share.Namespace, commitment []byte, appVersion uint64) (BlobProof, error) {
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(appVersion)
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), subtreeRootThreshold, txs...)
+	if err != nil {
+		return BlobProof{}, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return BlobProof{}, err
+	}
+
+	// erasure the data square while caching the inner nodes of the row trees
+	// to be able to look up the subtree roots of the blobs.
+	cacher := inclusion.NewSubtreeCacher(uint64(dataSquare.Size()))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(share.ToBytes(dataSquare), appconsts.DefaultCodec(), cacher.Constructor)
+	if err != nil {
+		return BlobProof{}, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return BlobProof{}, err
+	}
+
+	for txIndex, rawTx := range txs {
+		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlob {
+			continue
+		}
+		if err != nil {
+			return BlobProof{}, fmt.Errorf("unmarshalling blob tx at index %d: %w", txIndex, err)
+		}
+		for blobIndex, blob := range bTx.Blobs {
+			if !blob.Namespace().Equals(namespace) {
+				continue
+			}
+			start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+			if err != nil {
+				return BlobProof{}, err
+			}
+			length, err := builder.BlobShareLength(txIndex, blobIndex)
+			if err != nil {
+				return BlobProof{}, err
+			}
+			blobCommitment, err := inclusion.GetCommitment(cacher, dah, start, length, subtreeRootThreshold)
+			if err != nil {
+				return BlobProof{}, err
+			}
+			if !bytes.Equal(blobCommitment, commitment) {
+				continue
+			}
+			return newBlobProof(eds, cacher, dah, namespace, commitment, start, length, subtreeRootThreshold)
+		}
+	}
+	return BlobProof{}, fmt.Errorf("blob with share commitment %X not found in namespace %X", commitment, namespace.Bytes())
+}
+
+// newBlobProof creates the proof of the blob occupying the shares starting at
+// start in the extended data square.
+func newBlobProof(
+	eds *rsmt2d.ExtendedDataSquare,
+	cacher *inclusion.EDSSubTreeRootCacher,
+	dah da.DataAvailabilityHeader,
+	namespace share.Namespace,
+	commitment []byte,
+	start, length, subtreeRootThreshold int,
+) (BlobProof, error) {
+	shareProof, err := NewShareInclusionProofFromEDS(eds, namespace, share.NewRange(start, start+length))
+	if err != nil {
+		return BlobProof{}, err
+	}
+	rowSubtreeRoots, err := inclusion.GetSubTreeRoots(cacher, dah, start, length, subtreeRootThreshold)
+	if err != nil {
+		return BlobProof{}, err
+	}
+	subtreeRoots := make([]*RowSubtreeRoots, len(rowSubtreeRoots))
+	for i, roots := range rowSubtreeRoots {
+		subtreeRoots[i] = &RowSubtreeRoots{SubtreeRoots: roots}
+	}
+	return BlobProof{
+		ShareCommitment: commitment,
+		ShareProof:      &shareProof,
+		SubtreeRoots:    subtreeRoots,
+	}, nil
+}
+
+// Verify checks that the proof proves the inclusion of a blob with the share
+// commitment of the proof to the data root. It returns nil if the proof is
+// valid. Otherwise, it returns a sensible error.
+func (bp BlobProof) Verify(dataRoot []byte) error {
+	if bp.ShareProof == nil {
+		return errors.New("empty share proof")
+	}
+	if err := bp.ShareProof.Validate(dataRoot); err != nil {
+		return err
+	}
+
+	// the shares must contain a single blob and nothing else, so that the
+	// share commitment commits to a whole blob.
+	if _, err := bp.Blob(); err != nil {
+		return err
+	}
+
+	if len(bp.SubtreeRoots) != len(bp.ShareProof.ShareProofs) {
+		return fmt.Errorf("the number of rows of subtree roots %d must equal the number of share proofs %d", len(bp.SubtreeRoots), len(bp.ShareProof.ShareProofs))
+	}
+	// the subtree root threshold has been the same across all app versions.
+	subtreeWidth := squareinclusion.SubTreeWidth(len(bp.ShareProof.Data), appconsts.DefaultSubtreeRootThreshold)
+	hasher := nmt.NewNmtHasher(appconsts.NewBaseHashFunc(), share.NamespaceSize, true)
+	subtreeRoots := make([][]byte, 0)
+	for i, proof := range bp.ShareProof.ShareProofs {
+		if bp.SubtreeRoots[i] == nil {
+			return fmt.Errorf("missing subtree roots of row %d", i)
+		}
+		// the subtree roots are verified against the range proof of the shares,
+		// so they commit to exactly the proven shares.
+		nmtProof := nmt.NewInclusionProof(int(proof.Start), int(proof.End), proof.Nodes, true)
+		valid, err := nmtProof.VerifySubtreeRootInclusion(hasher, bp.SubtreeRoots[i].SubtreeRoots, subtreeWidth, bp.ShareProof.RowProof.RowRoots[i])
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return fmt.Errorf("subtree roots of row %d failed to verify", i)
+		}
+		subtreeRoots = append(subtreeRoots, bp.SubtreeRoots[i].SubtreeRoots...)
+	}
+
+	if !bytes.Equal(merkle.HashFromByteSlices(subtreeRoots), bp.ShareCommitment) {
+		return errors.New("subtree roots don't match the share commitment")
+	}
+	return nil
+}
+
+// Blob parses the blob from the shares of the proof. It returns an error if
+// the shares don't contain exactly one blob.
+func (bp BlobProof) Blob() (*share.Blob, error) {
+	if bp.ShareProof == nil {
+		return nil, errors.New("empty share proof")
+	}
+	shares, err := share.FromBytes(bp.ShareProof.Data)
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := share.ParseBlobs(shares)
+	if err != nil {
+		return nil, err
+	}
+	if len(blobs) != 1 {
+		return nil, fmt.Errorf("expected the shares to contain 1 blob, got %d", len(blobs))
+	}
+	blobShares, err := blobs[0].ToShares()
+	if err != nil {
+		return nil, err
+	}
+	if len(blobShares) != len(shares) {
+		return nil, fmt.Errorf("expected the blob to occupy %d shares, got %d", len(shares), len(blobShares))
+	}
+	return blobs[0], nil
+}
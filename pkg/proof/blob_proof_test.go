@@ -0,0 +1,143 @@
+package proof_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlobProof(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := testfactory.GenerateRandomTxs(10, 500)
+	// the second blob of ns1 spans multiple rows
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns2, ns1}, []int{500, 1000, 30000})
+	txs = append(txs, blobTxs...)
+	rawTxs := txs.ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(rawTxs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	for i, rawTx := range blobTxs {
+		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		require.True(t, isBlob)
+		require.NoError(t, err)
+		blob := bTx.Blobs[0]
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.DefaultSubtreeRootThreshold)
+		require.NoError(t, err)
+
+		blobProof, err := proof.NewBlobProof(rawTxs, blob.Namespace(), commitment, appconsts.LatestVersion)
+		require.NoError(t, err, "blob %d", i)
+		assert.Equal(t, commitment, blobProof.ShareCommitment)
+		assert.NoError(t, blobProof.Verify(dataRoot), "blob %d", i)
+
+		provenBlob, err := blobProof.Blob()
+		require.NoError(t, err)
+		assert.Equal(t, blob.Data(), provenBlob.Data())
+	}
+
+	bTx, _, err := blobtx.UnmarshalBlobTx(blobTxs[2])
+	require.NoError(t, err)
+	commitment, err := inclusion.CreateCommitment(bTx.Blobs[0], merkle.HashFromByteSlices, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+
+	t.Run("blob spanning multiple rows", func(t *testing.T) {
+		blobProof, err := proof.NewBlobProof(rawTxs, ns1, commitment, appconsts.LatestVersion)
+		require.NoError(t, err)
+		assert.Greater(t, len(blobProof.SubtreeRoots), 1)
+	})
+
+	t.Run("unknown share commitment", func(t *testing.T) {
+		_, err := proof.NewBlobProof(rawTxs, ns1, bytes.Repeat([]byte{1}, 32), appconsts.LatestVersion)
+		assert.Error(t, err)
+	})
+
+	t.Run("share commitment of another namespace", func(t *testing.T) {
+		_, err := proof.NewBlobProof(rawTxs, ns2, commitment, appconsts.LatestVersion)
+		assert.Error(t, err)
+	})
+
+	t.Run("tampered proofs are rejected", func(t *testing.T) {
+		blobProof, err := proof.NewBlobProof(rawTxs, ns1, commitment, appconsts.LatestVersion)
+		require.NoError(t, err)
+		assert.Error(t, blobProof.Verify(bytes.Repeat([]byte{1}, 32)))
+
+		wrongCommitment := blobProof
+		wrongCommitment.ShareCommitment = bytes.Repeat([]byte{1}, 32)
+		assert.Error(t, wrongCommitment.Verify(dataRoot))
+
+		tamperedSubtreeRoot, err := proof.NewBlobProof(rawTxs, ns1, commitment, appconsts.LatestVersion)
+		require.NoError(t, err)
+		root := tamperedSubtreeRoot.SubtreeRoots[0].SubtreeRoots[0]
+		root[len(root)-1] ^= 0xFF
+		assert.Error(t, tamperedSubtreeRoot.Verify(dataRoot))
+
+		missingRow, err := proof.NewBlobProof(rawTxs, ns1, commitment, appconsts.LatestVersion)
+		require.NoError(t, err)
+		missingRow.SubtreeRoots = missingRow.SubtreeRoots[1:]
+		assert.Error(t, missingRow.Verify(dataRoot))
+	})
+}
+
+func TestQueryBlobProof(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns}, []int{5000})
+	bTx, _, err := blobtx.UnmarshalBlobTx(txs[0])
+	require.NoError(t, err)
+	commitment, err := inclusion.CreateCommitment(bTx.Blobs[0], merkle.HashFromByteSlices, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+
+	block := tmproto.Block{
+		Header: tmproto.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}},
+		Data:   tmproto.Data{Txs: coretypes.Txs(txs).ToSliceOfBytes()},
+	}
+	rawBlock, err := block.Marshal()
+	require.NoError(t, err)
+
+	path := []string{hex.EncodeToString(ns.Bytes()), hex.EncodeToString(commitment)}
+	rawProof, err := proof.QueryBlobProof(sdk.Context{}, path, abci.RequestQuery{Data: rawBlock})
+	require.NoError(t, err)
+	var blobProof proof.BlobProof
+	require.NoError(t, blobProof.Unmarshal(rawProof))
+
+	dataSquare, err := square.Construct(block.Data.Txs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	assert.NoError(t, blobProof.Verify(dah.Hash()))
+
+	_, err = proof.QueryBlobProof(sdk.Context{}, path[:1], abci.RequestQuery{Data: rawBlock})
+	assert.Error(t, err)
+}
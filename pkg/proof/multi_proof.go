@@ -0,0 +1,137 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// NewMultiProof returns a Merkle proof that the items at the indexes exist in
+// the Merkle tree of the items. The indexes must be in increasing order.
+func NewMultiProof(items [][]byte, indexes []int) (MultiProof, error) {
+	if err := validateIndexes(indexes, len(items)); err != nil {
+		return MultiProof{}, err
+	}
+	aunts := make([][]byte, 0)
+	collectAunts(items, 0, indexes, &aunts)
+	return MultiProof{
+		Total: int64(len(items)),
+		Aunts: aunts,
+	}, nil
+}
+
+// collectAunts traverses the tree of the items and collects the roots of the
+// subtrees that don't contain any of the indexes. offset is the index of the
+// first item in the tree.
+func collectAunts(items [][]byte, offset int, indexes []int, aunts *[][]byte) {
+	if len(indexes) == 0 {
+		*aunts = append(*aunts, merkle.HashFromByteSlices(items))
+		return
+	}
+	if len(items) == 1 {
+		return
+	}
+	k := splitPoint(len(items))
+	left, right := splitIndexes(indexes, offset+k)
+	collectAunts(items[:k], offset, left, aunts)
+	collectAunts(items[k:], offset+k, right, aunts)
+}
+
+// Verify checks that the leaves exist at the indexes of a Merkle tree with the
+// given root. The indexes must be in increasing order. It returns nil if the
+// proof is valid. Otherwise, it returns a sensible error.
+func (mp MultiProof) Verify(root []byte, indexes []int, leaves [][]byte) error {
+	if mp.Total <= 0 {
+		return fmt.Errorf("proof total %d must be positive", mp.Total)
+	}
+	if len(indexes) != len(leaves) {
+		return fmt.Errorf("the number of indexes %d must equal the number of leaves %d", len(indexes), len(leaves))
+	}
+	if err := validateIndexes(indexes, int(mp.Total)); err != nil {
+		return err
+	}
+	aunts := mp.Aunts
+	computed, err := computeMultiProofRoot(0, int(mp.Total), indexes, leaves, &aunts)
+	if err != nil {
+		return err
+	}
+	if len(aunts) != 0 {
+		return fmt.Errorf("%d unused aunts", len(aunts))
+	}
+	if !bytes.Equal(computed, root) {
+		return errors.New("multiproof failed to verify")
+	}
+	return nil
+}
+
+// computeMultiProofRoot computes the root of the subtree of the leaves in the
+// range [start, end) using the leaves it contains and the aunts.
+func computeMultiProofRoot(start, end int, indexes []int, leaves [][]byte, aunts *[][]byte) ([]byte, error) {
+	if len(indexes) == 0 {
+		if len(*aunts) == 0 {
+			return nil, fmt.Errorf("missing aunt for range [%d, %d)", start, end)
+		}
+		aunt := (*aunts)[0]
+		*aunts = (*aunts)[1:]
+		return aunt, nil
+	}
+	if end-start == 1 {
+		return tmhash.Sum(append([]byte{0}, leaves[0]...)), nil
+	}
+	k := splitPoint(end - start)
+	leftIndexes, rightIndexes := splitIndexes(indexes, start+k)
+	left, err := computeMultiProofRoot(start, start+k, leftIndexes, leaves[:len(leftIndexes)], aunts)
+	if err != nil {
+		return nil, err
+	}
+	right, err := computeMultiProofRoot(start+k, end, rightIndexes, leaves[len(leftIndexes):], aunts)
+	if err != nil {
+		return nil, err
+	}
+	return tmhash.Sum(append(append([]byte{1}, left...), right...)), nil
+}
+
+// validateIndexes checks that the indexes are in increasing order and within
+// a tree of total leaves.
+func validateIndexes(indexes []int, total int) error {
+	if len(indexes) == 0 {
+		return errors.New("no index to prove")
+	}
+	for i, index := range indexes {
+		if index < 0 || index >= total {
+			return fmt.Errorf("index %d out of bounds of a tree of %d leaves", index, total)
+		}
+		if i > 0 && index <= indexes[i-1] {
+			return fmt.Errorf("indexes must be in increasing order: %d follows %d", index, indexes[i-1])
+		}
+	}
+	return nil
+}
+
+// splitIndexes splits the increasing indexes in the ones lower than k and the
+// others.
+func splitIndexes(indexes []int, k int) ([]int, []int) {
+	for i, index := range indexes {
+		if index >= k {
+			return indexes[:i], indexes[i:]
+		}
+	}
+	return indexes, nil
+}
+
+// splitPoint returns the largest power of 2 less than length, which is where
+// the merkle package splits a tree of length leaves.
+func splitPoint(length int) int {
+	if length < 1 {
+		panic("trying to split a tree with size < 1")
+	}
+	k := 1 << (bits.Len(uint(length)) - 1)
+	if k == length {
+		k >>= 1
+	}
+	return k
+}
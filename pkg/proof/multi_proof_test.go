@@ -0,0 +1,72 @@
+package proof_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+)
+
+func TestMultiProof(t *testing.T) {
+	tests := []struct {
+		total   int
+		indexes []int
+	}{
+		{total: 1, indexes: []int{0}},
+		{total: 2, indexes: []int{1}},
+		{total: 7, indexes: []int{0, 6}},
+		{total: 8, indexes: []int{0, 1, 2, 3, 4, 5, 6, 7}},
+		{total: 16, indexes: []int{3}},
+		{total: 16, indexes: []int{2, 3, 9, 15}},
+		{total: 13, indexes: []int{4, 5, 12}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d of %d leaves", len(tt.indexes), tt.total), func(t *testing.T) {
+			items := make([][]byte, tt.total)
+			for i := range items {
+				items[i] = tmrand.Bytes(32)
+			}
+			root := merkle.HashFromByteSlices(items)
+			leaves := make([][]byte, len(tt.indexes))
+			for i, index := range tt.indexes {
+				leaves[i] = items[index]
+			}
+
+			multiProof, err := proof.NewMultiProof(items, tt.indexes)
+			require.NoError(t, err)
+			assert.NoError(t, multiProof.Verify(root, tt.indexes, leaves))
+
+			// a different leaf fails to verify
+			tampered := append([][]byte{tmrand.Bytes(32)}, leaves[1:]...)
+			assert.Error(t, multiProof.Verify(root, tt.indexes, tampered))
+
+			// a different root fails to verify
+			assert.Error(t, multiProof.Verify(tmrand.Bytes(32), tt.indexes, leaves))
+		})
+	}
+
+	t.Run("unsorted indexes are rejected", func(t *testing.T) {
+		items := [][]byte{{1}, {2}, {3}}
+		_, err := proof.NewMultiProof(items, []int{2, 1})
+		assert.Error(t, err)
+	})
+
+	t.Run("out of bounds indexes are rejected", func(t *testing.T) {
+		items := [][]byte{{1}, {2}, {3}}
+		_, err := proof.NewMultiProof(items, []int{3})
+		assert.Error(t, err)
+	})
+
+	t.Run("shifted indexes are rejected", func(t *testing.T) {
+		items := [][]byte{{1}, {2}, {3}, {4}}
+		multiProof, err := proof.NewMultiProof(items, []int{1})
+		require.NoError(t, err)
+		assert.Error(t, multiProof.Verify(merkle.HashFromByteSlices(items), []int{0}, [][]byte{{2}}))
+	})
+}
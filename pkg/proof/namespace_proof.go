@@ -0,0 +1,190 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// NewNamespaceProof takes an extended data square and returns a proof of all
+// the shares of the namespace in its original data square. The proof contains
+// an NMT proof for every row whose row root covers the namespace: a range
+// proof of the shares of the namespace in the row or, if the row doesn't
+// contain any, a proof of absence of the namespace. The rows whose row root
+// doesn't cover the namespace can't contain it so they aren't proven.
+func NewNamespaceProof(eds *rsmt2d.ExtendedDataSquare, namespace share.Namespace) (NamespaceProof, error) {
+	rowRoots, err := eds.RowRoots()
+	if err != nil {
+		return NamespaceProof{}, err
+	}
+
+	squareSize := eds.Width() / 2
+	rowProofs := make([]*RowNamespaceProof, 0)
+	for row := uint(0); row < squareSize; row++ {
+		if !rootCoversNamespace(rowRoots[row], namespace) {
+			continue
+		}
+		rowProof, err := newRowNamespaceProof(eds, squareSize, row, rowRoots[row], namespace)
+		if err != nil {
+			return NamespaceProof{}, err
+		}
+		rowProofs = append(rowProofs, rowProof)
+	}
+
+	return NamespaceProof{
+		NamespaceId:      namespace.ID(),
+		NamespaceVersion: uint32(namespace.Version()),
+		RowProofs:        rowProofs,
+	}, nil
+}
+
+// newRowNamespaceProof returns a proof of the shares of the namespace in the
+// row of the extended data square.
+func newRowNamespaceProof(eds *rsmt2d.ExtendedDataSquare, squareSize, row uint, rowRoot []byte, namespace share.Namespace) (*RowNamespaceProof, error) {
+	// we have to re-create the tree as the eds one is not accessible.
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), row)
+	shares := eds.Row(row)
+	for _, sh := range shares {
+		if err := tree.Push(sh); err != nil {
+			return nil, err
+		}
+	}
+
+	// make sure that the generated root is the same as the eds row root.
+	root, err := tree.Root()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(rowRoot, root) {
+		return nil, errors.New("eds row root is different than tree root")
+	}
+
+	proof, err := tree.ProveNamespace(namespace.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	var rowShares [][]byte
+	if !proof.IsOfAbsence() {
+		rowShares = shares[proof.Start():proof.End()]
+	}
+	return &RowNamespaceProof{
+		Row:    uint32(row),
+		Shares: rowShares,
+		Proof: &NMTProof{
+			Start:    int32(proof.Start()),
+			End:      int32(proof.End()),
+			Nodes:    proof.Nodes(),
+			LeafHash: proof.LeafHash(),
+		},
+	}, nil
+}
+
+// Verify checks that the shares of the proof are all the shares of the
+// namespace in the data square committed to by the row roots of the data
+// availability header. It returns nil if the proof is valid. Otherwise, it
+// returns a sensible error.
+// Note: the data availability header must be checked against the data root of
+// the block separately.
+func (np NamespaceProof) Verify(dah da.DataAvailabilityHeader) error {
+	if np.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("invalid namespace version %d", np.NamespaceVersion)
+	}
+	namespace, err := share.NewNamespace(uint8(np.NamespaceVersion), np.NamespaceId)
+	if err != nil {
+		return err
+	}
+	if err := dah.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// every row of the original data square whose row root covers the
+	// namespace must be proven, in order.
+	squareSize := len(dah.RowRoots) / 2
+	cursor := 0
+	for row := 0; row < squareSize; row++ {
+		if !rootCoversNamespace(dah.RowRoots[row], namespace) {
+			continue
+		}
+		if cursor >= len(np.RowProofs) {
+			return fmt.Errorf("missing proof for row %d", row)
+		}
+		rowProof := np.RowProofs[cursor]
+		if rowProof == nil || int(rowProof.Row) != row {
+			return fmt.Errorf("missing proof for row %d", row)
+		}
+		if err := rowProof.verify(namespace, dah.RowRoots[row]); err != nil {
+			return fmt.Errorf("row %d: %w", row, err)
+		}
+		cursor++
+	}
+	if cursor != len(np.RowProofs) {
+		return fmt.Errorf("expected %d row proofs, got %d", cursor, len(np.RowProofs))
+	}
+	return nil
+}
+
+// Shares returns the shares of the namespace in the order they appear in the
+// data square.
+func (np NamespaceProof) Shares() [][]byte {
+	shares := make([][]byte, 0)
+	for _, rowProof := range np.RowProofs {
+		shares = append(shares, rowProof.Shares...)
+	}
+	return shares
+}
+
+// verify checks that the shares of the proof are all the shares of the
+// namespace in the row committed to by the root.
+func (rp RowNamespaceProof) verify(namespace share.Namespace, root []byte) error {
+	if rp.Proof == nil {
+		return errors.New("empty proof")
+	}
+	if rp.Proof.Start < 0 || rp.Proof.End <= rp.Proof.Start {
+		return fmt.Errorf("invalid proof range [%d, %d)", rp.Proof.Start, rp.Proof.End)
+	}
+
+	var proof nmt.Proof
+	if len(rp.Proof.LeafHash) != 0 {
+		if len(rp.Shares) != 0 {
+			return errors.New("proof of absence can't contain shares")
+		}
+		proof = nmt.NewAbsenceProof(int(rp.Proof.Start), int(rp.Proof.End), rp.Proof.Nodes, rp.Proof.LeafHash, true)
+	} else {
+		if len(rp.Shares) != int(rp.Proof.End-rp.Proof.Start) {
+			return fmt.Errorf("the number of shares %d must equal the proof range %d", len(rp.Shares), rp.Proof.End-rp.Proof.Start)
+		}
+		proof = nmt.NewInclusionProof(int(rp.Proof.Start), int(rp.Proof.End), rp.Proof.Nodes, true)
+	}
+
+	// the leaves of the row are the shares prefixed with their namespace
+	leaves := make([][]byte, len(rp.Shares))
+	for i, sh := range rp.Shares {
+		if len(sh) < share.NamespaceSize {
+			return fmt.Errorf("share %d is too short to contain a namespace", i)
+		}
+		leaves[i] = append(append(make([]byte, 0, share.NamespaceSize+len(sh)), sh[:share.NamespaceSize]...), sh...)
+	}
+	if !proof.VerifyNamespace(appconsts.NewBaseHashFunc(), namespace.Bytes(), leaves, root) {
+		return errors.New("namespace proof failed to verify")
+	}
+	return nil
+}
+
+// rootCoversNamespace returns true if the namespace is within the namespace
+// range of the NMT root.
+func rootCoversNamespace(root []byte, namespace share.Namespace) bool {
+	if len(root) < 2*share.NamespaceSize {
+		return false
+	}
+	minNamespace := nmt.MinNamespace(root, share.NamespaceSize)
+	maxNamespace := nmt.MaxNamespace(root, share.NamespaceSize)
+	return bytes.Compare(minNamespace, namespace.Bytes()) <= 0 && bytes.Compare(namespace.Bytes(), maxNamespace) <= 0
+}
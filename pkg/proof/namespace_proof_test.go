@@ -0,0 +1,161 @@
+package proof_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNamespaceProof(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	ns3 := share.MustNewV0Namespace(bytes.Repeat([]byte{3}, share.NamespaceVersionZeroIDSize))
+	// lower than all the namespaces of the square
+	outOfRange := share.MustNewV0Namespace(bytes.Repeat([]byte{0}, share.NamespaceVersionZeroIDSize))
+
+	txs := testfactory.GenerateRandomTxs(10, 500)
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	// the blob of ns3 spans multiple rows
+	txs = append(txs, blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns3}, []int{500, 20000})...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		namespace share.Namespace
+		// wantShares are the shares of the namespace in the data square
+		wantShares [][]byte
+		// wantRows is true if the namespace is covered by at least one row
+		wantRows bool
+	}{
+		{
+			name:       "namespace of a single row",
+			namespace:  ns1,
+			wantShares: namespaceShares(dataSquare, ns1),
+			wantRows:   true,
+		},
+		{
+			name:       "namespace spanning multiple rows",
+			namespace:  ns3,
+			wantShares: namespaceShares(dataSquare, ns3),
+			wantRows:   true,
+		},
+		{
+			name:       "namespace absent from a row covering it",
+			namespace:  ns2,
+			wantShares: [][]byte{},
+			wantRows:   true,
+		},
+		{
+			name:       "namespace not covered by any row",
+			namespace:  outOfRange,
+			wantShares: [][]byte{},
+			wantRows:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsProof, err := proof.NewNamespaceProof(eds, tt.namespace)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRows, len(nsProof.RowProofs) != 0)
+			assert.Equal(t, tt.wantShares, nsProof.Shares())
+			assert.NoError(t, nsProof.Verify(dah))
+		})
+	}
+
+	t.Run("multiple rows are proven", func(t *testing.T) {
+		nsProof, err := proof.NewNamespaceProof(eds, ns3)
+		require.NoError(t, err)
+		assert.Greater(t, len(nsProof.RowProofs), 1)
+	})
+
+	t.Run("tampered proofs are rejected", func(t *testing.T) {
+		nsProof, err := proof.NewNamespaceProof(eds, ns3)
+		require.NoError(t, err)
+
+		withoutLastRow := nsProof
+		withoutLastRow.RowProofs = nsProof.RowProofs[:len(nsProof.RowProofs)-1]
+		assert.Error(t, withoutLastRow.Verify(dah))
+
+		tamperedShare, err := proof.NewNamespaceProof(eds, ns3)
+		require.NoError(t, err)
+		tamperedShare.RowProofs[0].Shares[0][len(tamperedShare.RowProofs[0].Shares[0])-1] ^= 0xFF
+		assert.Error(t, tamperedShare.Verify(dah))
+
+		absence, err := proof.NewNamespaceProof(eds, ns2)
+		require.NoError(t, err)
+		absence.RowProofs[0].Shares = [][]byte{dataSquare[0].ToBytes()}
+		assert.Error(t, absence.Verify(dah))
+
+		otherNamespace, err := proof.NewNamespaceProof(eds, ns1)
+		require.NoError(t, err)
+		otherNamespace.NamespaceId = ns3.ID()
+		assert.Error(t, otherNamespace.Verify(dah))
+	})
+}
+
+func TestQueryNamespaceProof(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns}, []int{5000})
+
+	block := tmproto.Block{
+		Header: tmproto.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}},
+		Data:   tmproto.Data{Txs: coretypes.Txs(txs).ToSliceOfBytes()},
+	}
+	rawBlock, err := block.Marshal()
+	require.NoError(t, err)
+
+	rawProof, err := proof.QueryNamespaceProof(sdk.Context{}, []string{hex.EncodeToString(ns.Bytes())}, abci.RequestQuery{Data: rawBlock})
+	require.NoError(t, err)
+	var nsProof proof.NamespaceProof
+	require.NoError(t, nsProof.Unmarshal(rawProof))
+
+	dataSquare, err := square.Construct(block.Data.Txs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	assert.NoError(t, nsProof.Verify(dah))
+	assert.Equal(t, namespaceShares(dataSquare, ns), nsProof.Shares())
+
+	_, err = proof.QueryNamespaceProof(sdk.Context{}, []string{"not hex"}, abci.RequestQuery{Data: rawBlock})
+	assert.Error(t, err)
+}
+
+// namespaceShares returns the raw shares of the namespace in the data square.
+func namespaceShares(dataSquare square.Square, namespace share.Namespace) [][]byte {
+	shares := make([][]byte, 0)
+	for _, sh := range dataSquare {
+		if sh.Namespace().Equals(namespace) {
+			shares = append(shares, sh.ToBytes())
+		}
+	}
+	return shares
+}
@@ -20,31 +20,41 @@ import (
 // NewTxInclusionProof returns a new share inclusion proof for the given
 // transaction index.
 func NewTxInclusionProof(txs [][]byte, txIndex, appVersion uint64) (ShareProof, error) {
+	dataSquare, shareRange, err := txShareRange(txs, txIndex, appVersion)
+	if err != nil {
+		return ShareProof{}, err
+	}
+
+	namespace := getTxNamespace(txs[txIndex])
+	return NewShareInclusionProof(dataSquare, namespace, shareRange)
+}
+
+// txShareRange builds the square of the transactions and returns it along with
+// the range of shares of the transaction at txIndex.
+func txShareRange(txs [][]byte, txIndex, appVersion uint64) (square.Square, share.Range, error) {
 	if txIndex >= uint64(len(txs)) {
-		return ShareProof{}, fmt.Errorf("txIndex %d out of bounds", txIndex)
+		return nil, share.Range{}, fmt.Errorf("txIndex %d out of bounds", txIndex)
 	}
 
 	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion), txs...)
 	if err != nil {
-		return ShareProof{}, err
+		return nil, share.Range{}, err
 	}
 
 	dataSquare, err := builder.Export()
 	if err != nil {
-		return ShareProof{}, err
+		return nil, share.Range{}, err
 	}
 
 	txIndexInt, err := safeConvertUint64ToInt(txIndex)
 	if err != nil {
-		return ShareProof{}, err
+		return nil, share.Range{}, err
 	}
 	shareRange, err := builder.FindTxShareRange(txIndexInt)
 	if err != nil {
-		return ShareProof{}, err
+		return nil, share.Range{}, err
 	}
-
-	namespace := getTxNamespace(txs[txIndex])
-	return NewShareInclusionProof(dataSquare, namespace, shareRange)
+	return dataSquare, shareRange, nil
 }
 
 func getTxNamespace(tx []byte) (ns share.Namespace) {
@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
 
 	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
@@ -283,3 +285,70 @@ func TestQueryTxInclusionProofRejectsNegativeValues(t *testing.T) {
 		t.Fatal("no rawProof expected")
 	}
 }
+
+func TestQueryInclusionProofsReuseCachedSquare(t *testing.T) {
+	blockTxs := testfactory.GenerateRandomTxs(20, 500).ToSliceOfBytes()
+	dataSquare, err := square.Construct(blockTxs, appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	block := tmproto.Block{
+		Header: tmproto.Header{
+			Height:   10,
+			DataHash: dah.Hash(),
+			Version:  tmversion.Consensus{App: appconsts.LatestVersion},
+		},
+		Data: tmproto.Data{Txs: blockTxs},
+	}
+	rawBlock, err := block.Marshal()
+	require.NoError(t, err)
+	req := abci.RequestQuery{Data: rawBlock}
+
+	cache := da.NewCache(da.DefaultCacheSize)
+	txQuerier := proof.NewTxInclusionProofQuerier(cache)
+	shareQuerier := proof.NewShareInclusionProofQuerier(cache)
+
+	// queries don't cache the squares of the blocks they are provided
+	rawProof, err := txQuerier(sdk.Context{}, []string{"3"}, req)
+	require.NoError(t, err)
+	assert.Equal(t, da.CacheStats{Misses: 1}, cache.Stats())
+	uncachedRawProof, err := proof.QueryTxInclusionProof(sdk.Context{}, []string{"3"}, req)
+	require.NoError(t, err)
+	assert.Equal(t, uncachedRawProof, rawProof)
+
+	// the square is cached once its proposal is processed
+	cache.Add(block.Header.Height, eds, dah)
+
+	// the following queries for the same block reuse it
+	rawProof, err = txQuerier(sdk.Context{}, []string{"3"}, req)
+	require.NoError(t, err)
+	assert.Equal(t, uncachedRawProof, rawProof)
+	rawProof, err = shareQuerier(sdk.Context{}, []string{"0", "2"}, req)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), cache.Stats().Hits)
+	var shareProof proof.ShareProof
+	require.NoError(t, shareProof.Unmarshal(rawProof))
+	assert.NoError(t, shareProof.Validate(dah.Hash()))
+
+	// a block of a cached height with another data hash doesn't replace the
+	// cached square
+	block.Header.DataHash = bytes.Repeat([]byte{1}, 32)
+	rawBlock, err = block.Marshal()
+	require.NoError(t, err)
+	_, err = txQuerier(sdk.Context{}, []string{"3"}, abci.RequestQuery{Data: rawBlock})
+	require.NoError(t, err)
+	_, _, ok := cache.Get(block.Header.Height, dah.Hash())
+	assert.True(t, ok)
+
+	// nor does a block of a later height get cached
+	block.Header.Height = 11
+	block.Header.DataHash = dah.Hash()
+	rawBlock, err = block.Marshal()
+	require.NoError(t, err)
+	_, err = txQuerier(sdk.Context{}, []string{"3"}, abci.RequestQuery{Data: rawBlock})
+	require.NoError(t, err)
+	assert.Equal(t, 1, cache.Stats().Entries)
+}
@@ -2,13 +2,16 @@ package proof
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strconv"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
 	"github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -25,42 +28,59 @@ const TxInclusionQueryPath = "txInclusionProof"
 //
 // example path for proving the third transaction in that block:
 // custom/txInclusionProof/3
-func QueryTxInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
-	// parse the index from the path
-	if len(path) != 1 {
-		return nil, fmt.Errorf("expected query path length: 1 actual: %d ", len(path))
-	}
-	index, err := strconv.ParseInt(path[0], 10, 64)
-	if err != nil {
-		return nil, err
-	}
-	if index < 0 {
-		return nil, fmt.Errorf("path[0] element: %q produced a negative value: %d", path[0], index)
-	}
+func QueryTxInclusionProof(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	return NewTxInclusionProofQuerier(nil)(ctx, path, req)
+}
 
-	// unmarshal the block data that is passed from the ABCI client
-	pbb := new(tmproto.Block)
-	err = pbb.Unmarshal(req.Data)
-	if err != nil {
-		return nil, fmt.Errorf("error reading block: %w", err)
-	}
-	data, err := types.DataFromProto(&pbb.Data)
-	if err != nil {
-		panic(fmt.Errorf("error from proto block: %w", err))
-	}
+// NewTxInclusionProofQuerier returns a QueryTxInclusionProof querier that
+// reuses the extended data squares of the cache. A nil cache disables caching.
+func NewTxInclusionProofQuerier(cache *da.Cache) sdk.Querier {
+	return func(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		// parse the index from the path
+		if len(path) != 1 {
+			return nil, fmt.Errorf("expected query path length: 1 actual: %d ", len(path))
+		}
+		index, err := strconv.ParseInt(path[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("path[0] element: %q produced a negative value: %d", path[0], index)
+		}
 
-	// create and marshal the tx inclusion proof, which we return in the form of []byte
-	shareProof, err := NewTxInclusionProof(data.Txs.ToSliceOfBytes(), uint64(index), pbb.Header.Version.App)
-	if err != nil {
-		return nil, err
-	}
+		// unmarshal the block data that is passed from the ABCI client
+		pbb := new(tmproto.Block)
+		err = pbb.Unmarshal(req.Data)
+		if err != nil {
+			return nil, fmt.Errorf("error reading block: %w", err)
+		}
+		data, err := types.DataFromProto(&pbb.Data)
+		if err != nil {
+			panic(fmt.Errorf("error from proto block: %w", err))
+		}
 
-	rawShareProof, err := shareProof.Marshal()
-	if err != nil {
-		return nil, err
-	}
+		// create and marshal the tx inclusion proof, which we return in the form of []byte
+		txs := data.Txs.ToSliceOfBytes()
+		dataSquare, shareRange, err := txShareRange(txs, uint64(index), pbb.Header.Version.App)
+		if err != nil {
+			return nil, err
+		}
+		eds, err := extendSquare(cache, pbb.Header, dataSquare)
+		if err != nil {
+			return nil, err
+		}
+		shareProof, err := NewShareInclusionProofFromEDS(eds, getTxNamespace(txs[index]), shareRange)
+		if err != nil {
+			return nil, err
+		}
+
+		rawShareProof, err := shareProof.Marshal()
+		if err != nil {
+			return nil, err
+		}
 
-	return rawShareProof, nil
+		return rawShareProof, nil
+	}
 }
 
 const ShareInclusionQueryPath = "shareInclusionProof"
@@ -69,16 +89,160 @@ const ShareInclusionQueryPath = "shareInclusionProof"
 // inclusion proofs of a set of shares to the data root. The share range should
 // be appended to the path. Example path for proving the set of shares [3, 5]:
 // custom/shareInclusionProof/3/5
-func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
-	// parse the share range from the path
+func QueryShareInclusionProof(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	return NewShareInclusionProofQuerier(nil)(ctx, path, req)
+}
+
+// NewShareInclusionProofQuerier returns a QueryShareInclusionProof querier
+// that reuses the extended data squares of the cache. A nil cache disables
+// caching.
+func NewShareInclusionProofQuerier(cache *da.Cache) sdk.Querier {
+	return func(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		// parse the share range from the path
+		if len(path) != 2 {
+			return nil, fmt.Errorf("expected query path length: 2 actual: %d ", len(path))
+		}
+		beginShare, err := strconv.ParseInt(path[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		endShare, err := strconv.ParseInt(path[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		// unmarshal the block data that is passed from the ABCI client
+		pbb := new(tmproto.Block)
+		err = pbb.Unmarshal(req.Data)
+		if err != nil {
+			return nil, fmt.Errorf("error reading block: %w", err)
+		}
+
+		// construct the data square from the block data. As we don't have
+		// access to the application's state machine we use the upper bound
+		// square size instead of the square size dictated from governance
+		dataSquare, err := square.Construct(pbb.Data.Txs, appconsts.SquareSizeUpperBound(pbb.Header.Version.App), appconsts.SubtreeRootThreshold(pbb.Header.Version.App))
+		if err != nil {
+			return nil, err
+		}
+
+		begin, err := safeConvertInt64ToInt(beginShare)
+		if err != nil {
+			return nil, err
+		}
+		end, err := safeConvertInt64ToInt(endShare)
+		if err != nil {
+			return nil, err
+		}
+
+		nID, err := ParseNamespace(dataSquare, begin, end)
+		if err != nil {
+			return nil, err
+		}
+
+		shareRange := share.NewRange(begin, end)
+		// create and marshal the share inclusion proof, which we return in the form of []byte
+		eds, err := extendSquare(cache, pbb.Header, dataSquare)
+		if err != nil {
+			return nil, err
+		}
+		shareProof, err := NewShareInclusionProofFromEDS(eds, nID, shareRange)
+		if err != nil {
+			return nil, err
+		}
+
+		rawShareProof, err := shareProof.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return rawShareProof, nil
+	}
+}
+
+const NamespaceProofQueryPath = "namespaceProof"
+
+// QueryNamespaceProof defines the logic performed when querying for the proof
+// of all the shares of a namespace to the row roots of the data availability
+// header. The hex encoded namespace should be appended to the path. Example
+// path for proving the shares of a namespace:
+// custom/namespaceProof/<hex namespace>
+func QueryNamespaceProof(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	return NewNamespaceProofQuerier(nil)(ctx, path, req)
+}
+
+// NewNamespaceProofQuerier returns a QueryNamespaceProof querier that reuses
+// the extended data squares of the cache. A nil cache disables caching.
+func NewNamespaceProofQuerier(cache *da.Cache) sdk.Querier {
+	return func(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		// parse the namespace from the path
+		if len(path) != 1 {
+			return nil, fmt.Errorf("expected query path length: 1 actual: %d ", len(path))
+		}
+		rawNamespace, err := hex.DecodeString(path[0])
+		if err != nil {
+			return nil, err
+		}
+		namespace, err := share.NewNamespaceFromBytes(rawNamespace)
+		if err != nil {
+			return nil, err
+		}
+
+		// unmarshal the block data that is passed from the ABCI client
+		pbb := new(tmproto.Block)
+		err = pbb.Unmarshal(req.Data)
+		if err != nil {
+			return nil, fmt.Errorf("error reading block: %w", err)
+		}
+
+		// construct the data square from the block data. As we don't have
+		// access to the application's state machine we use the upper bound
+		// square size instead of the square size dictated from governance
+		dataSquare, err := square.Construct(pbb.Data.Txs, appconsts.SquareSizeUpperBound(pbb.Header.Version.App), appconsts.SubtreeRootThreshold(pbb.Header.Version.App))
+		if err != nil {
+			return nil, err
+		}
+		eds, err := extendSquare(cache, pbb.Header, dataSquare)
+		if err != nil {
+			return nil, err
+		}
+
+		// create and marshal the namespace proof, which we return in the form of []byte
+		namespaceProof, err := NewNamespaceProof(eds, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		rawNamespaceProof, err := namespaceProof.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return rawNamespaceProof, nil
+	}
+}
+
+const BlobProofQueryPath = "blobProof"
+
+// QueryBlobProof defines the logic performed when querying for the inclusion
+// proof of a blob, identified by its namespace and share commitment, to the
+// data root. The hex encoded namespace and share commitment should be appended
+// to the path. Example path for proving a blob:
+// custom/blobProof/<hex namespace>/<hex share commitment>
+func QueryBlobProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	// parse the namespace and share commitment from the path
 	if len(path) != 2 {
 		return nil, fmt.Errorf("expected query path length: 2 actual: %d ", len(path))
 	}
-	beginShare, err := strconv.ParseInt(path[0], 10, 64)
+	rawNamespace, err := hex.DecodeString(path[0])
 	if err != nil {
 		return nil, err
 	}
-	endShare, err := strconv.ParseInt(path[1], 10, 64)
+	namespace, err := share.NewNamespaceFromBytes(rawNamespace)
+	if err != nil {
+		return nil, err
+	}
+	commitment, err := hex.DecodeString(path[1])
 	if err != nil {
 		return nil, err
 	}
@@ -90,41 +254,30 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuer
 		return nil, fmt.Errorf("error reading block: %w", err)
 	}
 
-	// construct the data square from the block data. As we don't have
-	// access to the application's state machine we use the upper bound
-	// square size instead of the square size dictated from governance
-	dataSquare, err := square.Construct(pbb.Data.Txs, appconsts.SquareSizeUpperBound(pbb.Header.Version.App), appconsts.SubtreeRootThreshold(pbb.Header.Version.App))
-	if err != nil {
-		return nil, err
-	}
-
-	begin, err := safeConvertInt64ToInt(beginShare)
-	if err != nil {
-		return nil, err
-	}
-	end, err := safeConvertInt64ToInt(endShare)
+	// create and marshal the blob proof, which we return in the form of []byte
+	blobProof, err := NewBlobProof(pbb.Data.Txs, namespace, commitment, pbb.Header.Version.App)
 	if err != nil {
 		return nil, err
 	}
 
-	nID, err := ParseNamespace(dataSquare, begin, end)
+	rawBlobProof, err := blobProof.Marshal()
 	if err != nil {
 		return nil, err
 	}
 
-	shareRange := share.NewRange(begin, end)
-	// create and marshal the share inclusion proof, which we return in the form of []byte
-	shareProof, err := NewShareInclusionProof(dataSquare, nID, shareRange)
-	if err != nil {
-		return nil, err
-	}
+	return rawBlobProof, nil
+}
 
-	rawShareProof, err := shareProof.Marshal()
-	if err != nil {
-		return nil, err
+// extendSquare returns the extended data square of the block's data square,
+// reusing the one of the cache if the cache holds the square of the block's
+// height and data root. The block is provided by the caller of the query, so
+// the squares it extends are never added to the cache: only the squares of
+// the blocks of the node are.
+func extendSquare(cache *da.Cache, header tmproto.Header, dataSquare square.Square) (*rsmt2d.ExtendedDataSquare, error) {
+	if eds, _, ok := cache.Get(header.Height, header.DataHash); ok {
+		return eds, nil
 	}
-
-	return rawShareProof, nil
+	return da.ExtendShares(share.ToBytes(dataSquare))
 }
 
 // ParseNamespace validates the share range, checks if it only contains one namespace and returns
@@ -0,0 +1,331 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+)
+
+const (
+	// DefaultBatchFlushInterval is the default maximum time a blob waits in
+	// the batcher before it is submitted.
+	DefaultBatchFlushInterval = 2 * time.Second
+
+	// batchTxFixedOverhead is a conservative estimate of the bytes of a signed
+	// MsgPayForBlobs transaction that do not depend on the blobs it pays for.
+	batchTxFixedOverhead = 1024
+	// batchBlobOverhead is a conservative estimate of the bytes added to a
+	// BlobTx for every blob on top of its data. It covers the blob info in the
+	// MsgPayForBlobs and the namespace and versions of the blob itself.
+	batchBlobOverhead = blobtypes.BytesPerBlobInfo + 64
+)
+
+// ErrBatcherStopped is returned for blobs that are submitted to a batcher
+// that has been stopped.
+var ErrBatcherStopped = errors.New("blob batcher stopped")
+
+// BlobBatcherConfig configures when a BlobBatcher flushes a batch.
+type BlobBatcherConfig struct {
+	// MaxBatchBytes is the maximum size in bytes of a BlobTx. It must not
+	// exceed appconsts.MaxTxSize.
+	MaxBatchBytes int
+	// MaxSquareSize is the max square size of the network. A batch never
+	// occupies more shares than fit in a square of this size.
+	MaxSquareSize int
+	// MaxBatchBlobs is the maximum number of blobs in a batch. Zero means
+	// unlimited.
+	MaxBatchBlobs int
+	// FlushInterval is the maximum time a blob waits before it is submitted.
+	FlushInterval time.Duration
+}
+
+// DefaultBlobBatcherConfig returns a BlobBatcherConfig that packs batches up
+// to the limits of the latest app version with the default governance max
+// square size.
+func DefaultBlobBatcherConfig() BlobBatcherConfig {
+	return BlobBatcherConfig{
+		MaxBatchBytes: appconsts.MaxTxSize(appconsts.LatestVersion),
+		MaxSquareSize: appconsts.DefaultGovMaxSquareSize,
+		FlushInterval: DefaultBatchFlushInterval,
+	}
+}
+
+// ValidateBasic performs stateless validation of the config.
+func (cfg BlobBatcherConfig) ValidateBasic() error {
+	if cfg.MaxBatchBytes <= batchTxFixedOverhead+batchBlobOverhead {
+		return fmt.Errorf("max batch bytes %d is too small", cfg.MaxBatchBytes)
+	}
+	if cfg.MaxBatchBytes > appconsts.MaxTxSize(appconsts.LatestVersion) {
+		return fmt.Errorf("max batch bytes %d exceeds the max tx size %d", cfg.MaxBatchBytes, appconsts.MaxTxSize(appconsts.LatestVersion))
+	}
+	if cfg.MaxSquareSize <= 0 {
+		return fmt.Errorf("max square size must be positive, got %d", cfg.MaxSquareSize)
+	}
+	if cfg.MaxBatchBlobs < 0 {
+		return fmt.Errorf("max batch blobs must be non-negative, got %d", cfg.MaxBatchBlobs)
+	}
+	if cfg.FlushInterval <= 0 {
+		return fmt.Errorf("flush interval must be positive, got %s", cfg.FlushInterval)
+	}
+	return nil
+}
+
+// BlobResult is the outcome of submitting a single blob through a BlobBatcher.
+type BlobResult struct {
+	// Height is the height of the block that included the blob.
+	Height int64
+	// TxHash is the hash of the MsgPayForBlobs transaction that paid for the blob.
+	TxHash string
+	// Commitment is the share commitment of the blob.
+	Commitment []byte
+	// Err is set if the blob could not be included.
+	Err error
+}
+
+type blobRequest struct {
+	blob   *share.Blob
+	result chan BlobResult
+}
+
+// BlobBatcher accumulates blobs and submits them together in as few
+// MsgPayForBlobs as possible. A batch is submitted once the next blob would
+// not fit in it or once its oldest blob has waited for the flush interval.
+// Batches are submitted concurrently using the TxClient.
+// BlobBatcher is thread-safe.
+type BlobBatcher struct {
+	client   *TxClient
+	cfg      BlobBatcherConfig
+	opts     []TxOption
+	requests chan *blobRequest
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	// stop is closed to signal the batcher to stop accepting blobs
+	stop chan struct{}
+	// done is closed when all batches have been submitted
+	done chan struct{}
+	// inFlight tracks the batches that are being submitted
+	inFlight sync.WaitGroup
+}
+
+// NewBlobBatcher returns a BlobBatcher that submits batches with the
+// provided client. The TxOptions are applied to every batch.
+func NewBlobBatcher(client *TxClient, cfg BlobBatcherConfig, opts ...TxOption) (*BlobBatcher, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &BlobBatcher{
+		client:   client,
+		cfg:      cfg,
+		opts:     opts,
+		requests: make(chan *blobRequest),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// Start starts batching blobs in the background. Batches are submitted with
+// the provided context; cancelling it stops the batcher.
+func (b *BlobBatcher) Start(ctx context.Context) {
+	b.startOnce.Do(func() {
+		go b.run(ctx)
+	})
+}
+
+// Stop stops accepting new blobs, submits the pending ones and waits until
+// all batches have been confirmed. A batcher that was never started can't be
+// started after it was stopped.
+func (b *BlobBatcher) Stop() {
+	b.stopOnce.Do(func() {
+		close(b.stop)
+	})
+	b.startOnce.Do(func() {
+		close(b.done)
+	})
+	<-b.done
+}
+
+// Enqueue adds a blob to the batcher and returns a channel that receives the
+// result of its submission.
+func (b *BlobBatcher) Enqueue(ctx context.Context, blob *share.Blob) <-chan BlobResult {
+	req := &blobRequest{blob: blob, result: make(chan BlobResult, 1)}
+	if err := b.validateBlob(blob); err != nil {
+		req.result <- BlobResult{Err: err}
+		return req.result
+	}
+
+	select {
+	case b.requests <- req:
+	case <-b.stop:
+		req.result <- BlobResult{Err: ErrBatcherStopped}
+	case <-b.done:
+		req.result <- BlobResult{Err: ErrBatcherStopped}
+	case <-ctx.Done():
+		req.result <- BlobResult{Err: ctx.Err()}
+	}
+	return req.result
+}
+
+// Submit adds a blob to the batcher and blocks until it has been included in
+// a block.
+func (b *BlobBatcher) Submit(ctx context.Context, blob *share.Blob) (BlobResult, error) {
+	select {
+	case res := <-b.Enqueue(ctx, blob):
+		return res, res.Err
+	case <-ctx.Done():
+		return BlobResult{}, ctx.Err()
+	}
+}
+
+func (b *BlobBatcher) run(ctx context.Context) {
+	defer close(b.done)
+
+	var (
+		batch      []*blobRequest
+		batchBytes int
+		// flushTimer fires once the first blob of the batch has waited for
+		// the flush interval. It is only armed while the batch isn't empty.
+		flushTimer *time.Timer
+		flushC     <-chan time.Time
+	)
+	defer func() {
+		if flushTimer != nil {
+			flushTimer.Stop()
+		}
+	}()
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		flushTimer.Stop()
+		flushC = nil
+		b.inFlight.Add(1)
+		go b.submitBatch(ctx, batch)
+		batch, batchBytes = nil, 0
+	}
+
+	for {
+		select {
+		case req := <-b.requests:
+			blobBytes := len(req.blob.Data())
+			if !b.fits(batch, batchBytes, req.blob) {
+				flush()
+			}
+			if len(batch) == 0 {
+				flushTimer = time.NewTimer(b.cfg.FlushInterval)
+				flushC = flushTimer.C
+			}
+			batch = append(batch, req)
+			batchBytes += blobBytes
+			if b.cfg.MaxBatchBlobs > 0 && len(batch) >= b.cfg.MaxBatchBlobs {
+				flush()
+			}
+		case <-flushC:
+			flush()
+		case <-b.stop:
+			flush()
+			b.inFlight.Wait()
+			return
+		case <-ctx.Done():
+			for _, req := range batch {
+				req.result <- BlobResult{Err: ctx.Err()}
+			}
+			b.inFlight.Wait()
+			return
+		}
+	}
+}
+
+// validateBlob returns an error if the blob can't be submitted on its own
+// because it is invalid, exceeds the max batch bytes or occupies more shares
+// than fit in the largest square.
+func (b *BlobBatcher) validateBlob(blob *share.Blob) error {
+	if err := blobtypes.ValidateBlobs(blob); err != nil {
+		return err
+	}
+	if size := batchSize(1, len(blob.Data())); size > b.cfg.MaxBatchBytes {
+		return fmt.Errorf("blob of %d bytes exceeds max batch bytes %d", len(blob.Data()), b.cfg.MaxBatchBytes)
+	}
+	squareSize := b.maxSquareSize()
+	pfbTxSize := uint32(batchTxFixedOverhead + blobtypes.BytesPerBlobInfo)
+	if shares := sharesNeeded(pfbTxSize, []uint32{uint32(len(blob.Data()))}); shares > squareSize*squareSize {
+		return fmt.Errorf("blob of %d bytes needs %d shares which exceeds the %d shares of the max square size %d", len(blob.Data()), shares, squareSize*squareSize, squareSize)
+	}
+	return nil
+}
+
+// fits returns true if the blob can be added to the batch without exceeding
+// the max tx size or the number of shares available in a square.
+func (b *BlobBatcher) fits(batch []*blobRequest, batchBytes int, blob *share.Blob) bool {
+	if len(batch) == 0 {
+		return true
+	}
+	if batchSize(len(batch)+1, batchBytes+len(blob.Data())) > b.cfg.MaxBatchBytes {
+		return false
+	}
+
+	blobSizes := make([]uint32, 0, len(batch)+1)
+	for _, req := range batch {
+		blobSizes = append(blobSizes, uint32(len(req.blob.Data())))
+	}
+	blobSizes = append(blobSizes, uint32(len(blob.Data())))
+	pfbTxSize := uint32(batchTxFixedOverhead + blobtypes.BytesPerBlobInfo*len(blobSizes))
+	squareSize := b.maxSquareSize()
+	return sharesNeeded(pfbTxSize, blobSizes) <= squareSize*squareSize
+}
+
+// maxSquareSize returns the configured max square size, bounded by the
+// largest square of the app version.
+func (b *BlobBatcher) maxSquareSize() int {
+	return min(b.cfg.MaxSquareSize, appconsts.SquareSizeUpperBound(b.client.signer.appVersion))
+}
+
+func (b *BlobBatcher) submitBatch(ctx context.Context, batch []*blobRequest) {
+	defer b.inFlight.Done()
+
+	blobs := make([]*share.Blob, len(batch))
+	for i, req := range batch {
+		blobs[i] = req.blob
+	}
+
+	resp, err := b.client.SubmitPayForBlob(ctx, blobs, b.opts...)
+	for _, req := range batch {
+		if err != nil {
+			req.result <- BlobResult{Err: err}
+			continue
+		}
+		commitment, err := inclusion.CreateCommitment(req.blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(b.client.signer.appVersion))
+		req.result <- BlobResult{
+			Height:     resp.Height,
+			TxHash:     resp.TxHash,
+			Commitment: commitment,
+			Err:        err,
+		}
+	}
+}
+
+// batchSize estimates the size in bytes of a BlobTx paying for the given
+// number of blobs with the given total data size.
+func batchSize(numBlobs, dataBytes int) int {
+	return batchTxFixedOverhead + numBlobs*batchBlobOverhead + dataBytes
+}
+
+// sharesNeeded returns the number of shares needed by a PFB tx of the given
+// size and the blobs it pays for. It mirrors the check of the
+// BlobShareDecorator.
+func sharesNeeded(txSize uint32, blobSizes []uint32) int {
+	sum := share.CompactSharesNeeded(txSize)
+	for _, blobSize := range blobSizes {
+		sum += share.SparseSharesNeeded(blobSize)
+	}
+	return sum
+}
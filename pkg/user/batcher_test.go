@@ -0,0 +1,132 @@
+package user
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+)
+
+func TestBlobBatcherConfigValidateBasic(t *testing.T) {
+	require.NoError(t, DefaultBlobBatcherConfig().ValidateBasic())
+
+	cfg := DefaultBlobBatcherConfig()
+	cfg.MaxBatchBytes = appconsts.MaxTxSize(appconsts.LatestVersion) + 1
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultBlobBatcherConfig()
+	cfg.FlushInterval = 0
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultBlobBatcherConfig()
+	cfg.MaxSquareSize = 0
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func newTestBatcher(cfg BlobBatcherConfig) *BlobBatcher {
+	return &BlobBatcher{cfg: cfg, client: &TxClient{signer: &Signer{appVersion: appconsts.LatestVersion}}}
+}
+
+func TestBlobBatcherFits(t *testing.T) {
+	newBatch := func(blobs ...*share.Blob) ([]*blobRequest, int) {
+		batch := make([]*blobRequest, len(blobs))
+		size := 0
+		for i, blob := range blobs {
+			batch[i] = &blobRequest{blob: blob}
+			size += len(blob.Data())
+		}
+		return batch, size
+	}
+
+	t.Run("empty batch always fits", func(t *testing.T) {
+		b := newTestBatcher(DefaultBlobBatcherConfig())
+		require.True(t, b.fits(nil, 0, testfactory.GenerateRandomBlob(1e6)))
+	})
+
+	t.Run("max batch bytes", func(t *testing.T) {
+		cfg := DefaultBlobBatcherConfig()
+		cfg.MaxBatchBytes = batchSize(2, 2000)
+		b := newTestBatcher(cfg)
+		batch, size := newBatch(testfactory.GenerateRandomBlob(1000))
+		require.True(t, b.fits(batch, size, testfactory.GenerateRandomBlob(1000)))
+		require.False(t, b.fits(batch, size, testfactory.GenerateRandomBlob(1001)))
+	})
+
+	t.Run("max square size", func(t *testing.T) {
+		cfg := DefaultBlobBatcherConfig()
+		cfg.MaxSquareSize = 4
+		b := newTestBatcher(cfg)
+		// 16 shares are available, 3 of which are used by the PFB tx
+		batch, size := newBatch(testfactory.GenerateRandomBlobOfShareCount(8))
+		require.True(t, b.fits(batch, size, testfactory.GenerateRandomBlobOfShareCount(5)))
+		require.False(t, b.fits(batch, size, testfactory.GenerateRandomBlobOfShareCount(6)))
+	})
+
+	t.Run("square size upper bound", func(t *testing.T) {
+		cfg := DefaultBlobBatcherConfig()
+		cfg.MaxSquareSize = 2 * appconsts.SquareSizeUpperBound(appconsts.LatestVersion)
+		cfg.MaxBatchBytes = math.MaxInt
+		b := newTestBatcher(cfg)
+		upperBound := appconsts.SquareSizeUpperBound(appconsts.LatestVersion)
+		half := upperBound * upperBound * share.ContinuationSparseShareContentSize / 2
+		batch, size := newBatch(testfactory.GenerateRandomBlob(half))
+		require.False(t, b.fits(batch, size, testfactory.GenerateRandomBlob(half)))
+	})
+}
+
+func TestBlobBatcherStopWithoutStart(t *testing.T) {
+	b, err := NewBlobBatcher(newTestBatcher(DefaultBlobBatcherConfig()).client, DefaultBlobBatcherConfig())
+	require.NoError(t, err)
+
+	stopped := make(chan struct{})
+	go func() {
+		b.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Stop blocked on a batcher that was never started")
+	}
+	res := <-b.Enqueue(context.Background(), testfactory.GenerateRandomBlob(100))
+	require.ErrorIs(t, res.Err, ErrBatcherStopped)
+}
+
+func TestBlobBatcherValidateBlob(t *testing.T) {
+	t.Run("valid blob", func(t *testing.T) {
+		b := newTestBatcher(DefaultBlobBatcherConfig())
+		require.NoError(t, b.validateBlob(testfactory.GenerateRandomBlob(1000)))
+	})
+
+	t.Run("max batch bytes", func(t *testing.T) {
+		cfg := DefaultBlobBatcherConfig()
+		cfg.MaxBatchBytes = batchSize(1, 1000)
+		b := newTestBatcher(cfg)
+		require.NoError(t, b.validateBlob(testfactory.GenerateRandomBlob(1000)))
+		require.Error(t, b.validateBlob(testfactory.GenerateRandomBlob(1001)))
+	})
+
+	t.Run("max square size", func(t *testing.T) {
+		cfg := DefaultBlobBatcherConfig()
+		cfg.MaxSquareSize = 4
+		b := newTestBatcher(cfg)
+		// 16 shares are available, 3 of which are used by the PFB tx
+		require.NoError(t, b.validateBlob(testfactory.GenerateRandomBlobOfShareCount(13)))
+		require.Error(t, b.validateBlob(testfactory.GenerateRandomBlobOfShareCount(14)))
+	})
+
+	t.Run("square size upper bound", func(t *testing.T) {
+		cfg := DefaultBlobBatcherConfig()
+		cfg.MaxSquareSize = 2 * appconsts.SquareSizeUpperBound(appconsts.LatestVersion)
+		cfg.MaxBatchBytes = math.MaxInt
+		b := newTestBatcher(cfg)
+		upperBound := appconsts.SquareSizeUpperBound(appconsts.LatestVersion)
+		require.Error(t, b.validateBlob(testfactory.GenerateRandomBlob(upperBound*upperBound*share.ContinuationSparseShareContentSize)))
+	})
+}
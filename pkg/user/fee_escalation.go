@@ -0,0 +1,188 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/rpc/core"
+
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+)
+
+// WithEstimatorPriority makes the client query the node's gas price
+// estimator with the provided priority to price transactions that don't
+// specify a fee.
+func WithEstimatorPriority(priority gasestimation.TxPriority) Option {
+	return func(c *TxClient) {
+		c.estimatorPriority = priority
+	}
+}
+
+// WithMaxGasPrice caps the gas price used when pricing transactions with the
+// gas price estimator and when replacing pending transactions. SetupTxClient returns an error if the price is less
+// than the network min gas price.
+func WithMaxGasPrice(price float64) Option {
+	return func(c *TxClient) {
+		c.maxGasPrice = price
+	}
+}
+
+// WithFeeReplacement makes SubmitTx and SubmitPayForBlob replace a
+// transaction that is still pending after the given number of blocks by the
+// same transaction with its gas price multiplied by bumpFactor, capped by the
+// max gas price. The node only accepts a replacement whose gas price is at
+// least appconsts.MinReplacementGasPriceBumpPercent percent higher, so smaller
+// bump factors are rejected.
+func WithFeeReplacement(afterBlocks int64, bumpFactor float64) Option {
+	return func(c *TxClient) {
+		if afterBlocks <= 0 {
+			panic(fmt.Sprintf("fee replacement blocks must be positive, got %d", afterBlocks))
+		}
+		if bumpFactor < minReplacementBumpFactor {
+			panic(fmt.Sprintf("fee replacement bump factor must be at least %g, got %g", minReplacementBumpFactor, bumpFactor))
+		}
+		c.replaceAfterBlocks = afterBlocks
+		c.replacementBumpFactor = bumpFactor
+	}
+}
+
+// minReplacementBumpFactor is the smallest factor by which the gas price of a
+// pending transaction must be multiplied for the node to accept its replacement.
+const minReplacementBumpFactor = 1 + float64(appconsts.MinReplacementGasPriceBumpPercent)/100
+
+// gasPrice returns the gas price used for transactions that don't specify a
+// fee. If an estimator priority is set, the node's gas price estimator is
+// queried and the estimate is capped by the max gas price.
+func (client *TxClient) gasPrice(ctx context.Context) (float64, error) {
+	if client.estimatorPriority == gasestimation.TxPriority_TX_PRIORITY_UNSPECIFIED {
+		return appconsts.DefaultMinGasPrice, nil
+	}
+	price, err := client.signer.QueryGasPrice(ctx, client.grpc, client.estimatorPriority)
+	if err != nil {
+		return 0, fmt.Errorf("estimating gas price: %w", err)
+	}
+	return client.capGasPrice(price), nil
+}
+
+// capGasPrice limits the price to the max gas price, if set.
+func (client *TxClient) capGasPrice(price float64) float64 {
+	if client.maxGasPrice > 0 && price > client.maxGasPrice {
+		return client.maxGasPrice
+	}
+	return price
+}
+
+// latestHeight returns the height of the latest block of the node.
+func (client *TxClient) latestHeight(ctx context.Context) (int64, error) {
+	resp, err := tmservice.NewServiceClient(client.grpc).GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return resp.SdkBlock.Header.Height, nil
+}
+
+// replaceTx re-signs a pending transaction at its own sequence with a higher
+// gas price and broadcasts it. It returns the hash of the replacement and
+// false if the transaction could not be replaced, either because the max gas
+// price doesn't leave room for a high enough bump or because the node
+// rejected the replacement.
+func (client *TxClient) replaceTx(ctx context.Context, txHash string) (string, bool, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	info, exists := client.txTracker[txHash]
+	if !exists || len(info.txBytes) == 0 {
+		return "", false, nil
+	}
+
+	oldPrice, err := client.txGasPrice(info.txBytes)
+	if err != nil {
+		return "", false, err
+	}
+	newPrice := client.capGasPrice(oldPrice * client.replacementBumpFactor)
+	if newPrice < oldPrice*minReplacementBumpFactor {
+		return "", false, nil
+	}
+
+	// sign the replacement at the sequence of the pending transaction and
+	// restore the sequence of the signer afterwards
+	currentSequence := client.signer.accounts[info.signer].Sequence()
+	if err := client.signer.SetSequence(info.signer, info.sequence); err != nil {
+		return "", false, fmt.Errorf("setting sequence: %w", err)
+	}
+	txBytes, resignErr := client.resignTx(info.txBytes, newPrice/oldPrice)
+	if err := client.signer.SetSequence(info.signer, currentSequence); err != nil {
+		return "", false, fmt.Errorf("setting sequence: %w", err)
+	}
+	if resignErr != nil {
+		return "", false, fmt.Errorf("re-signing tx %s: %w", txHash, resignErr)
+	}
+
+	resp, err := sdktx.NewServiceClient(client.grpc).BroadcastTx(ctx, &sdktx.BroadcastTxRequest{
+		Mode:    sdktx.BroadcastMode_BROADCAST_MODE_SYNC,
+		TxBytes: txBytes,
+	})
+	if err != nil {
+		client.logger.Info("replacement of pending tx not broadcast", "tx_hash", txHash, "err", err)
+		return "", false, nil
+	}
+	if resp.TxResponse.Code != abci.CodeTypeOK {
+		// the original remains pending and keeps being confirmed
+		client.logger.Info("replacement of pending tx rejected", "tx_hash", txHash, "code", resp.TxResponse.Code, "log", resp.TxResponse.RawLog)
+		return "", false, nil
+	}
+
+	// only the replacement is resubmitted if the transactions of the signer
+	// are evicted
+	client.txTracker[txHash] = txInfo{sequence: info.sequence, signer: info.signer, timestamp: info.timestamp}
+	info.txBytes = txBytes
+	client.txTracker[resp.TxResponse.TxHash] = info
+	if client.journal != nil {
+		if err := client.journal.Put(resp.TxResponse.TxHash, info.journalEntry()); err != nil {
+			client.logger.Error("recording tx in journal", "tx_hash", resp.TxResponse.TxHash, "err", err)
+		}
+	}
+	return resp.TxResponse.TxHash, true, nil
+}
+
+// txGasPrice returns the gas price of a signed transaction which may be
+// wrapped in a BlobTx.
+func (client *TxClient) txGasPrice(txBytes []byte) (float64, error) {
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(txBytes)
+	if err != nil && isBlob {
+		return 0, err
+	}
+	if isBlob {
+		txBytes = bTx.Tx
+	}
+	sdkTx, err := client.signer.DecodeTx(txBytes)
+	if err != nil {
+		return 0, err
+	}
+	if sdkTx.GetGas() == 0 {
+		return 0, fmt.Errorf("tx has no gas limit")
+	}
+	fee := sdkTx.GetFee().AmountOf(appconsts.BondDenom)
+	return float64(fee.Uint64()) / float64(sdkTx.GetGas()), nil
+}
+
+// committedReplacement returns the status of the first of the provided
+// transactions that has been committed.
+func (client *TxClient) committedReplacement(ctx context.Context, txHashes []string) (string, *tx.TxStatusResponse, error) {
+	statuses, err := client.txStatuses(ctx, txHashes)
+	if err != nil {
+		return "", nil, err
+	}
+	for i, resp := range statuses {
+		if resp.Status == core.TxStatusCommitted {
+			return txHashes[i], resp, nil
+		}
+	}
+	return "", nil, nil
+}
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+)
+
+func TestGasPriceWithoutEstimator(t *testing.T) {
+	client := &TxClient{}
+	price, err := client.gasPrice(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, appconsts.DefaultMinGasPrice, price)
+}
+
+func TestCapGasPrice(t *testing.T) {
+	client := &TxClient{}
+	require.Equal(t, 10.0, client.capGasPrice(10))
+
+	WithMaxGasPrice(0.5)(client)
+	require.Equal(t, 0.5, client.capGasPrice(10))
+	require.Equal(t, 0.1, client.capGasPrice(0.1))
+}
+
+func TestWithFeeReplacement(t *testing.T) {
+	require.Panics(t, func() { WithFeeReplacement(0, 1.5)(&TxClient{}) })
+	require.Panics(t, func() { WithFeeReplacement(2, 1.05)(&TxClient{}) })
+
+	client := &TxClient{}
+	WithFeeReplacement(2, 1.5)(client)
+	require.EqualValues(t, 2, client.replaceAfterBlocks)
+	require.Equal(t, 1.5, client.replacementBumpFactor)
+}
+
+func TestTxGasPrice(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(encCfg.Codec)
+	signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, appconsts.LatestVersion, NewAccount(testfactory.TestAccName, 1, 0))
+	require.NoError(t, err)
+	client := &TxClient{signer: signer}
+	addr := signer.Account(testfactory.TestAccName).Address()
+
+	msg := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10)))
+	txBytes, err := signer.CreateTx([]sdk.Msg{msg}, SetGasLimitAndGasPrice(1000, 0.2))
+	require.NoError(t, err)
+	price, err := client.txGasPrice(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, 0.2, price)
+
+	blobTx, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, testfactory.GenerateRandomlySizedBlobs(1, 100), SetGasLimitAndGasPrice(2000, 0.004))
+	require.NoError(t, err)
+	price, err = client.txGasPrice(blobTx)
+	require.NoError(t, err)
+	require.Equal(t, 0.004, price)
+}
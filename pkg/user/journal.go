@@ -0,0 +1,90 @@
+package user
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// TxJournal persists the transactions that the TxClient has broadcast but not
+// yet seen committed, evicted or rejected. It allows a TxClient to recover its
+// local view of pending transactions and account sequences after a restart.
+// Implementations must be safe to call while the TxClient holds its lock.
+type TxJournal interface {
+	// Put records a transaction that was successfully broadcast.
+	Put(txHash string, entry JournalEntry) error
+	// Delete removes a transaction from the journal. Deleting a hash that
+	// does not exist is not an error.
+	Delete(txHash string) error
+	// Entries returns all transactions currently recorded in the journal
+	// keyed by their hash.
+	Entries() (map[string]JournalEntry, error)
+	// Close releases any resources held by the journal.
+	Close() error
+}
+
+// JournalEntry is the persisted form of a transaction in the local tx tracker.
+type JournalEntry struct {
+	Sequence  uint64    `json:"sequence"`
+	Signer    string    `json:"signer"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+var _ TxJournal = (*dbJournal)(nil)
+
+// dbJournal is a TxJournal backed by a tm-db database. Writes are synced to
+// disk so that entries survive a crash of the process.
+type dbJournal struct {
+	db dbm.DB
+}
+
+// NewTxJournal returns a TxJournal that stores entries in the provided database.
+func NewTxJournal(db dbm.DB) TxJournal {
+	return &dbJournal{db: db}
+}
+
+// NewLevelDBTxJournal opens (or creates) a LevelDB backed TxJournal in the
+// provided directory.
+func NewLevelDBTxJournal(dir string) (TxJournal, error) {
+	db, err := dbm.NewGoLevelDB("tx_journal", dir)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx journal: %w", err)
+	}
+	return NewTxJournal(db), nil
+}
+
+func (j *dbJournal) Put(txHash string, entry JournalEntry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return j.db.SetSync([]byte(txHash), bz)
+}
+
+func (j *dbJournal) Delete(txHash string) error {
+	return j.db.DeleteSync([]byte(txHash))
+}
+
+func (j *dbJournal) Entries() (map[string]JournalEntry, error) {
+	iter, err := j.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	entries := make(map[string]JournalEntry)
+	for ; iter.Valid(); iter.Next() {
+		var entry JournalEntry
+		if err := json.Unmarshal(iter.Value(), &entry); err != nil {
+			return nil, fmt.Errorf("decoding journal entry %s: %w", iter.Key(), err)
+		}
+		entries[string(iter.Key())] = entry
+	}
+	return entries, iter.Error()
+}
+
+func (j *dbJournal) Close() error {
+	return j.db.Close()
+}
@@ -0,0 +1,149 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+)
+
+func TestTxJournal(t *testing.T) {
+	journal := NewTxJournal(dbm.NewMemDB())
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, journal.Put("tx1", JournalEntry{Sequence: 1, Signer: "a", Timestamp: now}))
+	require.NoError(t, journal.Put("tx2", JournalEntry{Sequence: 2, Signer: "a", Timestamp: now}))
+
+	entries, err := journal.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, JournalEntry{Sequence: 1, Signer: "a", Timestamp: now}, entries["tx1"])
+
+	require.NoError(t, journal.Delete("tx1"))
+	// deleting a hash that doesn't exist is a no-op
+	require.NoError(t, journal.Delete("tx3"))
+
+	entries, err = journal.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Contains(t, entries, "tx2")
+}
+
+func TestLevelDBTxJournalPersists(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	journal, err := NewLevelDBTxJournal(dir)
+	require.NoError(t, err)
+	require.NoError(t, journal.Put("tx1", JournalEntry{Sequence: 7, Signer: "a", Timestamp: now}))
+	require.NoError(t, journal.Close())
+
+	journal, err = NewLevelDBTxJournal(dir)
+	require.NoError(t, err)
+	defer journal.Close()
+	entries, err := journal.Entries()
+	require.NoError(t, err)
+	require.Equal(t, map[string]JournalEntry{"tx1": {Sequence: 7, Signer: "a", Timestamp: now}}, entries)
+}
+
+func TestTxTrackerRemovalUpdatesJournal(t *testing.T) {
+	journal := NewTxJournal(dbm.NewMemDB())
+	txClient := &TxClient{
+		txTracker: make(map[string]txInfo),
+		journal:   journal,
+	}
+	stale := txInfo{signer: "a", sequence: 1, timestamp: time.Now().Add(-time.Hour)}
+	fresh := txInfo{signer: "a", sequence: 2, timestamp: time.Now()}
+	for hash, info := range map[string]txInfo{"stale": stale, "fresh": fresh} {
+		txClient.txTracker[hash] = info
+		require.NoError(t, journal.Put(hash, info.journalEntry()))
+	}
+
+	txClient.pruneTxTracker()
+	entries, err := journal.Entries()
+	require.NoError(t, err)
+	require.NotContains(t, entries, "stale")
+	require.Contains(t, entries, "fresh")
+
+	txClient.deleteFromTxTracker("fresh")
+	entries, err = journal.Entries()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestReplayJournalAccounts(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(encCfg.Codec, "unloaded")
+	signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, appconsts.LatestVersion, NewAccount(testfactory.TestAccName, 1, 5))
+	require.NoError(t, err)
+	// no node listens on the address so querying an account fails
+	conn, err := grpc.NewClient("127.0.0.1:1", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	newClient := func(journal TxJournal) *TxClient {
+		return &TxClient{
+			signer:    signer,
+			grpc:      conn,
+			registry:  encCfg.InterfaceRegistry,
+			txTracker: make(map[string]txInfo),
+			journal:   journal,
+		}
+	}
+
+	t.Run("drops the txs of accounts missing from the keyring", func(t *testing.T) {
+		journal := NewTxJournal(dbm.NewMemDB())
+		require.NoError(t, journal.Put("tx1", JournalEntry{Sequence: 1, Signer: "missing", Timestamp: time.Now()}))
+		require.NoError(t, newClient(journal).replayJournal(context.Background()))
+		entries, err := journal.Entries()
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("keeps the txs of accounts that fail to load", func(t *testing.T) {
+		journal := NewTxJournal(dbm.NewMemDB())
+		require.NoError(t, journal.Put("tx1", JournalEntry{Sequence: 1, Signer: "unloaded", Timestamp: time.Now()}))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		require.ErrorContains(t, newClient(journal).replayJournal(ctx), "querying account unloaded")
+		entries, err := journal.Entries()
+		require.NoError(t, err)
+		require.Contains(t, entries, "tx1")
+	})
+}
+
+// legacyTxStatusClient is the client of a node that doesn't support
+// TxStatusBatch.
+type legacyTxStatusClient struct {
+	tx.TxClient
+	statuses map[string]string
+}
+
+func (c *legacyTxStatusClient) TxStatusBatch(context.Context, *tx.TxStatusBatchRequest, ...grpc.CallOption) (*tx.TxStatusBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown method TxStatusBatch")
+}
+
+func (c *legacyTxStatusClient) TxStatus(_ context.Context, req *tx.TxStatusRequest, _ ...grpc.CallOption) (*tx.TxStatusResponse, error) {
+	return &tx.TxStatusResponse{Status: c.statuses[req.TxId]}, nil
+}
+
+func TestQueryTxStatusesWithoutBatch(t *testing.T) {
+	client := &legacyTxStatusClient{statuses: map[string]string{"tx1": "COMMITTED", "tx2": "PENDING"}}
+	statuses, err := queryTxStatuses(context.Background(), client, []string{"tx1", "tx2", "tx3"})
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	require.Equal(t, "COMMITTED", statuses[0].Status)
+	require.Equal(t, "PENDING", statuses[1].Status)
+	require.Equal(t, "", statuses[2].Status)
+}
@@ -0,0 +1,289 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+)
+
+// DefaultLaneFeeSpendLimit is the default amount of utia that each lane
+// account can spend on fees from the allowance of the default account.
+const DefaultLaneFeeSpendLimit = 1_000_000_000
+
+// LaneConfig configures the submission lanes of a TxClient. Each lane is
+// backed by its own keyring account and tracks its own sequence so that
+// SubmitPayForBlob calls can be signed and broadcast concurrently.
+type LaneConfig struct {
+	// Accounts is the number of lanes. The lane accounts are named using
+	// LaneAccountName.
+	Accounts int
+	// CreateAccounts creates the lane accounts that are missing from the
+	// keyring, initializes them on chain and grants them an allowance from
+	// the default account which then pays the fees of all lanes. As lane
+	// accounts are added to the keyring, the default account should be set
+	// explicitly using WithDefaultAccount.
+	CreateAccounts bool
+	// FeeSpendLimit is the amount of utia that each lane account created can
+	// spend on fees from the allowance of the default account. If zero,
+	// DefaultLaneFeeSpendLimit is used.
+	FeeSpendLimit int64
+}
+
+// ValidateBasic performs stateless validation of the lane config.
+func (c LaneConfig) ValidateBasic() error {
+	if c.Accounts < 1 {
+		return fmt.Errorf("number of lanes must be positive, got %d", c.Accounts)
+	}
+	if c.FeeSpendLimit < 0 {
+		return fmt.Errorf("fee spend limit cannot be negative, got %d", c.FeeSpendLimit)
+	}
+	return nil
+}
+
+// WithLanes enables submission lanes. The lanes are set up by SetupTxClient
+// after which SubmitPayForBlob dispatches blobs across the lanes concurrently.
+func WithLanes(config LaneConfig) Option {
+	return func(c *TxClient) {
+		if err := config.ValidateBasic(); err != nil {
+			panic(err)
+		}
+		c.laneConfig = &config
+	}
+}
+
+// LaneAccountName returns the name of the keyring account backing the lane
+// with the given index.
+func LaneAccountName(index int) string {
+	return fmt.Sprintf("lane-%d", index)
+}
+
+// lane is a TxClient dedicated to a single account along with counters of
+// the transactions it submitted.
+type lane struct {
+	client    *TxClient
+	submitted atomic.Uint64
+	confirmed atomic.Uint64
+	failed    atomic.Uint64
+}
+
+// LaneStats reports the transactions submitted through a single lane.
+type LaneStats struct {
+	Account  string
+	Address  sdktypes.AccAddress
+	Sequence uint64
+	// Submitted is the number of transactions submitted through the lane.
+	Submitted uint64
+	// Confirmed is the number of transactions that were committed successfully.
+	Confirmed uint64
+	// Failed is the number of transactions that failed to be broadcast,
+	// executed or confirmed.
+	Failed uint64
+}
+
+// SubmissionStats aggregates the stats of all lanes of a TxClient.
+type SubmissionStats struct {
+	Lanes     []LaneStats
+	Submitted uint64
+	Confirmed uint64
+	Failed    uint64
+}
+
+// SubmissionStats returns the stats of the submission lanes. It is empty if
+// lanes are not enabled.
+func (client *TxClient) SubmissionStats() SubmissionStats {
+	stats := SubmissionStats{Lanes: make([]LaneStats, 0, len(client.lanes))}
+	for _, l := range client.lanes {
+		laneStats := LaneStats{
+			Account:   l.client.defaultAccount,
+			Address:   l.client.defaultAddress,
+			Sequence:  l.client.Account(l.client.defaultAccount).Sequence(),
+			Submitted: l.submitted.Load(),
+			Confirmed: l.confirmed.Load(),
+			Failed:    l.failed.Load(),
+		}
+		stats.Lanes = append(stats.Lanes, laneStats)
+		stats.Submitted += laneStats.Submitted
+		stats.Confirmed += laneStats.Confirmed
+		stats.Failed += laneStats.Failed
+	}
+	return stats
+}
+
+// submitPayForBlobOnLane waits for an idle lane and uses it to submit the blobs.
+func (client *TxClient) submitPayForBlobOnLane(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
+	var l *lane
+	select {
+	case l = <-client.idleLanes:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { client.idleLanes <- l }()
+
+	if client.laneConfig.CreateAccounts {
+		// prepend the fee granter, so it can be overwritten in case the user has specified it.
+		opts = append([]TxOption{SetFeeGranter(client.defaultAddress)}, opts...)
+	}
+
+	l.submitted.Add(1)
+	resp, err := l.client.SubmitPayForBlob(ctx, blobs, opts...)
+	if err != nil {
+		l.failed.Add(1)
+		return nil, err
+	}
+	l.confirmed.Add(1)
+	return resp, nil
+}
+
+// setupLanes loads the lane accounts, creating and funding them first if
+// configured to, and starts a TxClient for each of them.
+func (client *TxClient) setupLanes(ctx context.Context) error {
+	cfg := client.laneConfig
+	keys := client.signer.keys
+
+	spendLimit := cfg.FeeSpendLimit
+	if spendLimit == 0 {
+		spendLimit = DefaultLaneFeeSpendLimit
+	}
+	allowance := &feegrant.BasicAllowance{
+		SpendLimit: sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, spendLimit)),
+	}
+
+	// batch together all the messages needed to initialize the lane accounts
+	msgs := make([]sdktypes.Msg, 0)
+	for i := 0; i < cfg.Accounts; i++ {
+		name := LaneAccountName(i)
+		record, err := keys.Key(name)
+		if err != nil {
+			if !cfg.CreateAccounts {
+				return fmt.Errorf("lane account %s not found in keyring: %w", name, err)
+			}
+			path := hd.CreateHDPath(sdktypes.CoinType, 0, 0).String()
+			record, _, err = keys.NewMnemonic(name, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+			if err != nil {
+				return fmt.Errorf("creating lane account %s: %w", name, err)
+			}
+		}
+		if !cfg.CreateAccounts {
+			continue
+		}
+
+		addr, err := record.GetAddress()
+		if err != nil {
+			return fmt.Errorf("retrieving address from keyring: %w", err)
+		}
+		// an account only exists on chain once it has received funds
+		if _, _, err := QueryAccount(ctx, client.grpc, client.registry, addr); err != nil {
+			msgs = append(msgs, bank.NewMsgSend(client.defaultAddress, addr, sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, 1))))
+		}
+		_, err = feegrant.NewQueryClient(client.grpc).Allowance(ctx, &feegrant.QueryAllowanceRequest{
+			Granter: client.defaultAddress.String(),
+			Grantee: addr.String(),
+		})
+		if err != nil {
+			grantMsg, err := feegrant.NewMsgGrantAllowance(allowance, client.defaultAddress, addr)
+			if err != nil {
+				return fmt.Errorf("creating feegrant message: %w", err)
+			}
+			msgs = append(msgs, grantMsg)
+		}
+	}
+
+	if len(msgs) > 0 {
+		if _, err := client.SubmitTx(ctx, msgs); err != nil {
+			return fmt.Errorf("initializing lane accounts: %w", err)
+		}
+	}
+
+	client.lanes = make([]*lane, 0, cfg.Accounts)
+	client.idleLanes = make(chan *lane, cfg.Accounts)
+	for i := 0; i < cfg.Accounts; i++ {
+		laneClient, err := client.newLaneClient(ctx, LaneAccountName(i))
+		if err != nil {
+			return err
+		}
+		l := &lane{client: laneClient}
+		client.lanes = append(client.lanes, l)
+		client.idleLanes <- l
+	}
+	return nil
+}
+
+// newLaneClient returns a TxClient that signs with the provided account only.
+// It prices, confirms and resubmits transactions like the client, and shares
+// its journal and status stream. The pending transactions of the lane
+// replayed from the journal by the client are handed over to the lane, and
+// the sequence of the lane account is the one of the client if it already
+// loaded the account so that it accounts for them.
+func (client *TxClient) newLaneClient(ctx context.Context, account string) (*TxClient, error) {
+	record, err := client.signer.keys.Key(account)
+	if err != nil {
+		return nil, fmt.Errorf("trying to find account %s on keyring: %w", account, err)
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving address from keyring: %w", err)
+	}
+	var accNum, sequence uint64
+	if acc := client.signer.Account(account); acc != nil {
+		accNum, sequence = acc.AccountNumber(), acc.Sequence()
+	} else {
+		accNum, sequence, err = QueryAccount(ctx, client.grpc, client.registry, addr)
+		if err != nil {
+			return nil, fmt.Errorf("querying lane account %s: %w", account, err)
+		}
+	}
+
+	signer, err := NewSigner(client.signer.keys, client.signer.enc, client.signer.chainID, client.signer.appVersion, NewAccount(account, accNum, sequence))
+	if err != nil {
+		return nil, err
+	}
+
+	laneClient, err := NewTxClient(signer, client.grpc, client.registry, client.laneOptions(account)...)
+	if err != nil {
+		return nil, err
+	}
+	laneClient.statusStream = client.statusStream
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	for hash, info := range client.txTracker {
+		if info.signer == account {
+			laneClient.txTracker[hash] = info
+			delete(client.txTracker, hash)
+		}
+	}
+	return laneClient, nil
+}
+
+// laneOptions returns the options of the client that apply to the lane of
+// the provided account.
+func (client *TxClient) laneOptions(account string) []Option {
+	options := []Option{
+		WithDefaultAccount(account),
+		WithPollTime(client.pollTime),
+		WithGasMultiplier(client.gasMultiplier),
+		WithDefaultGasPrice(client.defaultGasPrice),
+		WithEstimatorPriority(client.estimatorPriority),
+		WithMaxGasPrice(client.maxGasPrice),
+		WithLogger(client.logger),
+	}
+	if client.retryPolicy != nil {
+		options = append(options, WithRetryPolicy(*client.retryPolicy))
+	}
+	if client.replaceAfterBlocks > 0 {
+		options = append(options, WithFeeReplacement(client.replaceAfterBlocks, client.replacementBumpFactor))
+	}
+	if client.journal != nil {
+		options = append(options, WithTxJournal(client.journal))
+	}
+	return options
+}
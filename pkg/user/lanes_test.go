@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+)
+
+func TestNewLaneClient(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	laneAccount := LaneAccountName(0)
+	kr := testfactory.TestKeyring(encCfg.Codec, laneAccount)
+	signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, appconsts.LatestVersion,
+		NewAccount(testfactory.TestAccName, 1, 0), NewAccount(laneAccount, 2, 5))
+	require.NoError(t, err)
+
+	journal := NewTxJournal(dbm.NewMemDB())
+	client, err := NewTxClient(signer, nil, encCfg.InterfaceRegistry,
+		WithDefaultAccount(testfactory.TestAccName),
+		WithTxJournal(journal),
+		WithRetryPolicy(DefaultRetryPolicy()),
+		WithPollTime(time.Millisecond),
+		WithLanes(LaneConfig{Accounts: 1}),
+	)
+	require.NoError(t, err)
+	// the pending txs replayed from the journal
+	client.txTracker["parent"] = txInfo{sequence: 0, signer: testfactory.TestAccName}
+	client.txTracker["lane"] = txInfo{sequence: 4, signer: laneAccount}
+
+	laneClient, err := client.newLaneClient(context.Background(), laneAccount)
+	require.NoError(t, err)
+	require.Equal(t, laneAccount, laneClient.defaultAccount)
+	require.Nil(t, laneClient.laneConfig)
+	require.Equal(t, journal, laneClient.journal)
+	require.Equal(t, client.retryPolicy, laneClient.retryPolicy)
+	require.Equal(t, time.Millisecond, laneClient.pollTime)
+	require.EqualValues(t, 5, laneClient.Account(laneAccount).Sequence())
+
+	// the txs of the lane account are handed over to the lane
+	require.Equal(t, map[string]txInfo{"parent": {sequence: 0, signer: testfactory.TestAccName}}, client.txTracker)
+	require.Equal(t, map[string]txInfo{"lane": {sequence: 4, signer: laneAccount}}, laneClient.txTracker)
+}
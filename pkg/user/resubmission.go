@@ -0,0 +1,193 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+)
+
+// ErrTxEvicted is returned when a transaction was evicted from the mempool
+// before it could be included in a block.
+var ErrTxEvicted = errors.New("tx was evicted from the mempool")
+
+// RetryPolicy configures how SubmitTx and SubmitPayForBlob resubmit
+// transactions that were evicted from the mempool.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times an evicted transaction is
+	// resubmitted before the eviction is returned to the caller.
+	MaxAttempts int
+	// GasPriceBumpFactor multiplies the fee of the evicted transaction on
+	// every resubmission. A factor of 1 resubmits at the same gas price.
+	GasPriceBumpFactor float64
+	// Backoff is the time waited before each resubmission.
+	Backoff time.Duration
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that resubmits an evicted
+// transaction up to three times, raising the gas price by 20% each time.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:        3,
+		GasPriceBumpFactor: 1.2,
+		Backoff:            time.Second,
+	}
+}
+
+// ValidateBasic performs stateless validation of the retry policy.
+func (p RetryPolicy) ValidateBasic() error {
+	if p.MaxAttempts < 0 {
+		return fmt.Errorf("max attempts must be non-negative, got %d", p.MaxAttempts)
+	}
+	if p.GasPriceBumpFactor < 1 {
+		return fmt.Errorf("gas price bump factor must be at least 1, got %f", p.GasPriceBumpFactor)
+	}
+	if p.Backoff < 0 {
+		return fmt.Errorf("backoff must be non-negative, got %s", p.Backoff)
+	}
+	return nil
+}
+
+// WithRetryPolicy enables the automatic resubmission of evicted transactions
+// submitted via SubmitTx and SubmitPayForBlob.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *TxClient) {
+		if err := policy.ValidateBasic(); err != nil {
+			panic(err)
+		}
+		c.retryPolicy = &policy
+	}
+}
+
+// shouldResubmit returns true if a transaction that was evicted after the
+// given number of resubmissions should be resubmitted again.
+func (client *TxClient) shouldResubmit(attempts int) bool {
+	return client.retryPolicy != nil && attempts < client.retryPolicy.MaxAttempts
+}
+
+// resubmitEvictedTx re-signs an evicted transaction at its original sequence
+// with a bumped fee and broadcasts it. Transactions from the same signer with
+// a later sequence are kicked out of the mempool along with the evicted one,
+// so they are broadcast again afterwards. It returns the hash of the
+// resubmitted transaction.
+func (client *TxClient) resubmitEvictedTx(ctx context.Context, txHash string) (string, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	info, exists := client.txTracker[txHash]
+	if !exists {
+		return "", fmt.Errorf("tx: %s not found in tx client txTracker; likely failed during broadcast", txHash)
+	}
+	if err := client.signer.SetSequence(info.signer, info.sequence); err != nil {
+		return "", fmt.Errorf("setting sequence: %w", err)
+	}
+	client.removeFromTxTracker(txHash)
+	// the bytes of the transactions replayed from the journal aren't retained
+	if len(info.txBytes) == 0 {
+		return "", fmt.Errorf("%w: tx %s can't be resubmitted as its bytes weren't retained", ErrTxEvicted, txHash)
+	}
+
+	txBytes, err := client.resignTx(info.txBytes, client.retryPolicy.GasPriceBumpFactor)
+	if err != nil {
+		return "", fmt.Errorf("re-signing evicted tx %s: %w", txHash, err)
+	}
+	resp, err := client.broadcastTx(ctx, txBytes, info.signer)
+	if err != nil {
+		return "", fmt.Errorf("resubmitting evicted tx %s: %w", txHash, err)
+	}
+
+	client.rebroadcastLaterTxs(ctx, info.signer, info.sequence)
+	return resp.TxHash, nil
+}
+
+// resignTx decodes a previously signed transaction, multiplies its fee by
+// the provided factor and signs it again at the current sequence of the signer.
+func (client *TxClient) resignTx(txBytes []byte, feeFactor float64) ([]byte, error) {
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(txBytes)
+	if err != nil && isBlob {
+		return nil, err
+	}
+	if isBlob {
+		txBytes = bTx.Tx
+	}
+
+	sdkTx, err := client.signer.DecodeTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	fee := uint64(math.Ceil(float64(sdkTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) * feeFactor))
+	builder, err := client.signer.txBuilder(
+		sdkTx.GetMsgs(),
+		SetGasLimit(sdkTx.GetGas()),
+		SetFee(fee),
+		SetMemo(sdkTx.GetMemo()),
+		SetTimeoutHeight(sdkTx.GetTimeoutHeight()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if granter := sdkTx.FeeGranter(); granter != nil {
+		builder.SetFeeGranter(granter)
+	}
+	if payer := sdkTx.FeePayer(); payer != nil && !payer.Equals(sdkTx.GetSigners()[0]) {
+		builder.SetFeePayer(payer)
+	}
+
+	if _, _, err := client.signer.signTransaction(builder); err != nil {
+		return nil, err
+	}
+	txBytes, err = client.signer.EncodeTx(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	if isBlob {
+		return blobtx.MarshalBlobTx(txBytes, bTx.Blobs...)
+	}
+	return txBytes, nil
+}
+
+// rebroadcastLaterTxs broadcasts again, in order of their sequence, the
+// tracked transactions of the signer with a sequence after the provided one.
+// Their signed bytes remain valid so their hashes don't change and callers
+// waiting on them keep tracking the same transaction. It stops at the first
+// transaction that can't be broadcast; the sequence of the signer is left at
+// that transaction so that it can be resubmitted.
+// The caller must hold the lock.
+func (client *TxClient) rebroadcastLaterTxs(ctx context.Context, signer string, sequence uint64) {
+	var later []txInfo
+	for _, info := range client.txTracker {
+		if info.signer == signer && info.sequence > sequence && len(info.txBytes) > 0 {
+			later = append(later, info)
+		}
+	}
+	sort.Slice(later, func(i, j int) bool { return later[i].sequence < later[j].sequence })
+
+	txClient := sdktx.NewServiceClient(client.grpc)
+	for _, info := range later {
+		if info.sequence != client.signer.accounts[signer].Sequence() {
+			return
+		}
+		resp, err := txClient.BroadcastTx(ctx, &sdktx.BroadcastTxRequest{
+			Mode:    sdktx.BroadcastMode_BROADCAST_MODE_SYNC,
+			TxBytes: info.txBytes,
+		})
+		// the transaction may not have been kicked out of the mempool yet
+		alreadyExists := err != nil && strings.Contains(err.Error(), "already exists in")
+		if !alreadyExists && (err != nil || resp.TxResponse.Code != abci.CodeTypeOK) {
+			return
+		}
+		if err := client.signer.IncrementSequence(signer); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+)
+
+func TestRetryPolicyValidateBasic(t *testing.T) {
+	require.NoError(t, DefaultRetryPolicy().ValidateBasic())
+	require.NoError(t, RetryPolicy{GasPriceBumpFactor: 1}.ValidateBasic())
+	require.Error(t, RetryPolicy{MaxAttempts: -1, GasPriceBumpFactor: 1}.ValidateBasic())
+	require.Error(t, RetryPolicy{MaxAttempts: 1, GasPriceBumpFactor: 0.5}.ValidateBasic())
+	require.Error(t, RetryPolicy{MaxAttempts: 1, GasPriceBumpFactor: 1, Backoff: -time.Second}.ValidateBasic())
+}
+
+func TestResignTx(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(encCfg.Codec)
+	signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, appconsts.LatestVersion, NewAccount(testfactory.TestAccName, 1, 5))
+	require.NoError(t, err)
+	client := &TxClient{signer: signer, txTracker: make(map[string]txInfo)}
+	addr := signer.Account(testfactory.TestAccName).Address()
+
+	t.Run("sdk tx", func(t *testing.T) {
+		msg := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10)))
+		txBytes, err := signer.CreateTx([]sdk.Msg{msg}, SetGasLimit(1000), SetFee(100), SetMemo("memo"))
+		require.NoError(t, err)
+
+		resigned, err := client.resignTx(txBytes, 1.5)
+		require.NoError(t, err)
+		tx, err := signer.DecodeTx(resigned)
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, tx.GetGas())
+		require.EqualValues(t, 150, tx.GetFee().AmountOf(appconsts.BondDenom).Int64())
+		require.Equal(t, "memo", tx.GetMemo())
+		require.Equal(t, []sdk.Msg{msg}, tx.GetMsgs())
+	})
+
+	t.Run("blob tx", func(t *testing.T) {
+		blobs := testfactory.GenerateBlobsWithNamespace(2, 100, share.RandomBlobNamespace())
+		txBytes, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, blobs, SetGasLimit(1000), SetFee(100))
+		require.NoError(t, err)
+
+		resigned, err := client.resignTx(txBytes, 2)
+		require.NoError(t, err)
+		bTx, isBlob, err := blobtx.UnmarshalBlobTx(resigned)
+		require.NoError(t, err)
+		require.True(t, isBlob)
+		require.Len(t, bTx.Blobs, len(blobs))
+		tx, err := signer.DecodeTx(bTx.Tx)
+		require.NoError(t, err)
+		require.EqualValues(t, 200, tx.GetFee().AmountOf(appconsts.BondDenom).Int64())
+	})
+}
+
+func TestShouldResubmit(t *testing.T) {
+	client := &TxClient{}
+	require.False(t, client.shouldResubmit(0))
+
+	WithRetryPolicy(RetryPolicy{MaxAttempts: 2, GasPriceBumpFactor: 1})(client)
+	require.True(t, client.shouldResubmit(0))
+	require.True(t, client.shouldResubmit(1))
+	require.False(t, client.shouldResubmit(2))
+}
+
+func TestResubmitEvictedTxWithoutBytes(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(encCfg.Codec)
+	signer, err := NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, appconsts.LatestVersion, NewAccount(testfactory.TestAccName, 1, 5))
+	require.NoError(t, err)
+	client := &TxClient{signer: signer, txTracker: make(map[string]txInfo)}
+	WithRetryPolicy(DefaultRetryPolicy())(client)
+	// transactions replayed from the journal don't have their bytes
+	client.txTracker["tx"] = txInfo{signer: testfactory.TestAccName, sequence: 3}
+
+	_, err = client.resubmitEvictedTx(context.Background(), "tx")
+	require.ErrorIs(t, err, ErrTxEvicted)
+	require.EqualValues(t, 3, signer.Account(testfactory.TestAccName).Sequence())
+	require.NotContains(t, client.txTracker, "tx")
+}
+
+func TestLockEvictions(t *testing.T) {
+	client := &TxClient{txTracker: map[string]txInfo{
+		"a1": {signer: "a", sequence: 1},
+		"a2": {signer: "a", sequence: 2},
+		"b1": {signer: "b", sequence: 1},
+	}}
+
+	unlock, err := client.lockEvictions("a1")
+	require.NoError(t, err)
+
+	// the evictions of another signer are handled concurrently
+	unlockB, err := client.lockEvictions("b1")
+	require.NoError(t, err)
+	unlockB()
+
+	// while the ones of the same signer wait for the lock to be released
+	locked := make(chan struct{})
+	go func() {
+		unlock, err := client.lockEvictions("a2")
+		require.NoError(t, err)
+		unlock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatal("evictions of the same signer were handled concurrently")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+
+	_, err = client.lockEvictions("unknown")
+	require.Error(t, err)
+}
@@ -18,14 +18,19 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/rpc/core"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/gasestimation"
 	"github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
@@ -46,6 +51,17 @@ type txInfo struct {
 	sequence  uint64
 	signer    string
 	timestamp time.Time
+	// txBytes are the signed transaction bytes. They are only retained when
+	// a retry policy is set so that the transaction can be resubmitted.
+	txBytes []byte
+}
+
+func (info txInfo) journalEntry() JournalEntry {
+	return JournalEntry{
+		Sequence:  info.sequence,
+		Signer:    info.signer,
+		Timestamp: info.timestamp,
+	}
 }
 
 // TxResponse is a response from the chain after
@@ -112,6 +128,24 @@ func WithDefaultAddress(address sdktypes.AccAddress) Option {
 	}
 }
 
+// WithTxJournal persists the local tx tracker to the provided journal so that
+// pending transactions and account sequences can be recovered by SetupTxClient
+// after a restart.
+func WithTxJournal(journal TxJournal) Option {
+	return func(c *TxClient) {
+		c.journal = journal
+	}
+}
+
+// WithLogger sets the logger used to report the failures that don't fail the
+// operation they occur in, such as failing to record a broadcast transaction
+// in the journal. By default, nothing is logged.
+func WithLogger(logger log.Logger) Option {
+	return func(c *TxClient) {
+		c.logger = logger
+	}
+}
+
 func WithDefaultAccount(name string) Option {
 	return func(c *TxClient) {
 		rec, err := c.signer.keys.Key(name)
@@ -147,6 +181,31 @@ type TxClient struct {
 	// txTracker maps the tx hash to the Sequence and signer of the transaction
 	// that was submitted to the chain
 	txTracker map[string]txInfo
+	// journal optionally persists the txTracker across restarts
+	journal TxJournal
+	// retryPolicy optionally enables the resubmission of evicted transactions
+	retryPolicy *RetryPolicy
+	// estimatorPriority is the priority used to query the gas price estimator
+	// for transactions without a fee. If unspecified, the estimator isn't used.
+	estimatorPriority gasestimation.TxPriority
+	// maxGasPrice caps estimated and replacement gas prices if non-zero
+	maxGasPrice float64
+	// replaceAfterBlocks is the number of blocks after which a pending tx is
+	// replaced by one with a higher fee. Zero disables replacement.
+	replaceAfterBlocks    int64
+	replacementBumpFactor float64
+	// laneConfig optionally enables concurrent submission of blobs across
+	// multiple accounts
+	laneConfig *LaneConfig
+	lanes      []*lane
+	// idleLanes holds the lanes that are not currently submitting a tx
+	idleLanes chan *lane
+	// statusStream optionally replaces polling when confirming transactions
+	statusStream *txStatusStream
+	logger       log.Logger
+	// evictionLocks serialize the handling of the evictions of the
+	// transactions of each signer.
+	evictionLocks map[string]*sync.Mutex
 }
 
 // NewTxClient returns a new signer using the provided keyring
@@ -180,6 +239,7 @@ func NewTxClient(
 		defaultAccount:  records[0].Name,
 		defaultAddress:  addr,
 		txTracker:       make(map[string]txInfo),
+		logger:          log.NewNopLogger(),
 	}
 
 	for _, opt := range options {
@@ -241,18 +301,50 @@ func SetupTxClient(
 		return nil, fmt.Errorf("failed to create signer: %w", err)
 	}
 
-	return NewTxClient(signer, conn, encCfg.InterfaceRegistry, options...)
+	txClient, err := NewTxClient(signer, conn, encCfg.InterfaceRegistry, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if txClient.maxGasPrice > 0 {
+		networkMinPrice, err := QueryNetworkMinGasPrice(ctx, conn)
+		if err != nil && !strings.Contains(err.Error(), "unknown subspace: minfee") {
+			return nil, fmt.Errorf("querying network min gas price: %w", err)
+		}
+		if txClient.maxGasPrice < networkMinPrice {
+			return nil, fmt.Errorf("max gas price %g is less than the network min gas price %g", txClient.maxGasPrice, networkMinPrice)
+		}
+	}
+
+	if txClient.journal != nil {
+		if err := txClient.replayJournal(ctx); err != nil {
+			return nil, fmt.Errorf("replaying tx journal: %w", err)
+		}
+	}
+
+	if txClient.laneConfig != nil {
+		if err := txClient.setupLanes(ctx); err != nil {
+			return nil, fmt.Errorf("setting up lanes: %w", err)
+		}
+	}
+
+	return txClient, nil
 }
 
 // SubmitPayForBlob forms a transaction from the provided blobs, signs it, and submits it to the chain.
-// TxOptions may be provided to set the fee and gas limit.
+// TxOptions may be provided to set the fee and gas limit. If lanes are enabled, the transaction
+// is signed by the next idle lane account.
 func (client *TxClient) SubmitPayForBlob(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
+	if client.idleLanes != nil {
+		return client.submitPayForBlobOnLane(ctx, blobs, opts...)
+	}
+
 	resp, err := client.BroadcastPayForBlob(ctx, blobs, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	return client.ConfirmTx(ctx, resp.TxHash)
+	return client.confirmTx(ctx, resp.TxHash, true)
 }
 
 // SubmitPayForBlobWithAccount forms a transaction from the provided blobs, signs it with the provided account, and submits it to the chain.
@@ -263,7 +355,7 @@ func (client *TxClient) SubmitPayForBlobWithAccount(ctx context.Context, account
 		return nil, err
 	}
 
-	return client.ConfirmTx(ctx, resp.TxHash)
+	return client.confirmTx(ctx, resp.TxHash, true)
 }
 
 // BroadcastPayForBlob signs and broadcasts a transaction to pay for blobs.
@@ -286,8 +378,12 @@ func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, acco
 		blobSizes[i] = uint32(len(blob.Data()))
 	}
 
+	gasPrice, err := client.gasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
 	gasLimit := uint64(float64(types.DefaultEstimateGas(blobSizes)) * client.gasMultiplier)
-	fee := uint64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
+	fee := uint64(math.Ceil(gasPrice * float64(gasLimit)))
 	// prepend calculated params, so it can be overwritten in case the user has specified it.
 	opts = append([]TxOption{SetGasLimit(gasLimit), SetFee(fee)}, opts...)
 
@@ -307,7 +403,7 @@ func (client *TxClient) SubmitTx(ctx context.Context, msgs []sdktypes.Msg, opts
 		return nil, err
 	}
 
-	return client.ConfirmTx(ctx, resp.TxHash)
+	return client.confirmTx(ctx, resp.TxHash, true)
 }
 
 func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*sdktypes.TxResponse, error) {
@@ -355,7 +451,11 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 	}
 
 	if !hasUserSetFee {
-		fee := int64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
+		gasPrice, err := client.gasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		fee := int64(math.Ceil(gasPrice * float64(gasLimit)))
 		txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdktypes.NewInt(fee))))
 	}
 
@@ -395,17 +495,29 @@ func (client *TxClient) broadcastTx(ctx context.Context, txBytes []byte, signer
 
 	// save the sequence and signer of the transaction in the local txTracker
 	// before the sequence is incremented
-	client.txTracker[resp.TxResponse.TxHash] = txInfo{
+	info := txInfo{
 		sequence:  client.signer.accounts[signer].Sequence(),
 		signer:    signer,
 		timestamp: time.Now(),
 	}
+	if client.retryPolicy != nil || client.replaceAfterBlocks > 0 {
+		info.txBytes = txBytes
+	}
+	client.txTracker[resp.TxResponse.TxHash] = info
 
 	// after the transaction has been submitted, we can increment the
 	// sequence of the signer
 	if err := client.signer.IncrementSequence(signer); err != nil {
 		return nil, fmt.Errorf("increment sequencing: %w", err)
 	}
+
+	// the transaction has been broadcast so failing to journal it doesn't
+	// fail the broadcast: it is only not recovered after a restart.
+	if client.journal != nil {
+		if err := client.journal.Put(resp.TxResponse.TxHash, info.journalEntry()); err != nil {
+			client.logger.Error("recording tx in journal", "tx_hash", resp.TxResponse.TxHash, "err", err)
+		}
+	}
 	return resp.TxResponse, nil
 }
 
@@ -413,35 +525,80 @@ func (client *TxClient) broadcastTx(ctx context.Context, txBytes []byte, signer
 func (client *TxClient) pruneTxTracker() {
 	for hash, txInfo := range client.txTracker {
 		if time.Since(txInfo.timestamp) >= txTrackerPruningInterval {
-			delete(client.txTracker, hash)
+			client.removeFromTxTracker(hash)
 		}
 	}
 }
 
 // ConfirmTx periodically pings the provided node for the commitment of a transaction by its
 // hash. It will continually loop until the context is cancelled, the tx is found or an error
-// is encountered.
+// is encountered. If the client uses a status stream, the status of the transaction is
+// received from the stream instead.
 func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxResponse, error) {
-	txClient := tx.NewTxClient(client.grpc)
+	return client.confirmTx(ctx, txHash, false)
+}
 
-	pollTicker := time.NewTicker(client.pollTime)
-	defer pollTicker.Stop()
+// confirmTx behaves like ConfirmTx. If resubmit is true and the client has a
+// retry policy, evicted transactions are resubmitted instead of returning an
+// error, in which case the returned response refers to the resubmitted transaction.
+// Likewise, if resubmit is true and fee replacement is enabled, transactions
+// pending for too long are replaced and the returned response refers to
+// whichever of them was committed.
+func (client *TxClient) confirmTx(ctx context.Context, txHash string, resubmit bool) (*TxResponse, error) {
+	watcher := client.newTxStatusWatcher()
+	defer func() { watcher.stop() }()
+	resubmissions := 0
+	// replaced holds the hashes of the transactions that txHash replaced.
+	// Any of them may still be committed instead of the replacement.
+	var replaced []string
+	defer func() {
+		for _, hash := range replaced {
+			client.deleteFromTxTracker(hash)
+		}
+	}()
+	// pendingSince is the height at which the tx was first seen pending
+	var pendingSince int64
 
 	for {
-		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+		resp, err := watcher.next(ctx, txHash)
 		if err != nil {
 			return nil, err
 		}
 
+		if resp.Status != core.TxStatusCommitted && len(replaced) > 0 {
+			hash, committed, err := client.committedReplacement(ctx, replaced)
+			if err != nil {
+				return nil, err
+			}
+			if committed != nil {
+				client.deleteFromTxTracker(txHash)
+				txHash, resp = hash, committed
+			}
+		}
+
 		switch resp.Status {
 		case core.TxStatusPending:
-			// Continue polling if the transaction is still pending
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-pollTicker.C:
-				continue
+			if resubmit && client.replaceAfterBlocks > 0 {
+				height, err := client.latestHeight(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if pendingSince == 0 {
+					pendingSince = height
+				} else if height-pendingSince >= client.replaceAfterBlocks {
+					replacement, ok, err := client.replaceTx(ctx, txHash)
+					if err != nil {
+						return nil, err
+					}
+					if ok {
+						replaced = append(replaced, txHash)
+						txHash = replacement
+					}
+					pendingSince = height
+				}
 			}
+			// Continue watching if the transaction is still pending
+			continue
 		case core.TxStatusCommitted:
 			txResponse := &TxResponse{
 				Height: resp.Height,
@@ -460,7 +617,27 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 			client.deleteFromTxTracker(txHash)
 			return txResponse, nil
 		case core.TxStatusEvicted:
-			return nil, client.handleEvictions(txHash)
+			retry := resubmit && client.shouldResubmit(resubmissions)
+			if retry {
+				resubmissions++
+				select {
+				case <-ctx.Done():
+					client.deleteFromTxTracker(txHash)
+					return nil, ctx.Err()
+				case <-time.After(client.retryPolicy.Backoff):
+				}
+			}
+			evictedHash := txHash
+			txHash, err = client.handleEvictedTx(ctx, txHash, retry)
+			if err != nil {
+				return nil, err
+			}
+			if txHash == evictedHash {
+				// the transaction was broadcast again while handling the
+				// eviction of an earlier one, so it is watched again.
+				watcher.stop()
+				watcher = client.newTxStatusWatcher()
+			}
 		default:
 			client.deleteFromTxTracker(txHash)
 			if ctx.Err() != nil {
@@ -471,6 +648,56 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 	}
 }
 
+// handleEvictedTx handles the eviction of a transaction by resubmitting it if
+// resubmit is true or by returning ErrTxEvicted otherwise. It returns the hash
+// of the transaction to keep confirming. Handling an eviction rolls back the
+// sequence of the signer, so the evictions of the transactions of a signer are
+// handled one at a time. As handling the eviction of an earlier transaction
+// broadcasts the later ones again, the status of the transaction is checked
+// again before its eviction is handled.
+func (client *TxClient) handleEvictedTx(ctx context.Context, txHash string, resubmit bool) (string, error) {
+	unlock, err := client.lockEvictions(txHash)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	resp, err := tx.NewTxClient(client.grpc).TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+	if err != nil {
+		return "", err
+	}
+	if resp.Status != core.TxStatusEvicted {
+		return txHash, nil
+	}
+	if !resubmit {
+		return "", client.handleEvictions(txHash)
+	}
+	return client.resubmitEvictedTx(ctx, txHash)
+}
+
+// lockEvictions locks the handling of the evictions of the signer of the
+// transaction and returns the function unlocking it.
+func (client *TxClient) lockEvictions(txHash string) (func(), error) {
+	client.mtx.Lock()
+	info, exists := client.txTracker[txHash]
+	if !exists {
+		client.mtx.Unlock()
+		return nil, fmt.Errorf("tx: %s not found in tx client txTracker; likely failed during broadcast", txHash)
+	}
+	if client.evictionLocks == nil {
+		client.evictionLocks = make(map[string]*sync.Mutex)
+	}
+	lock, ok := client.evictionLocks[info.signer]
+	if !ok {
+		lock = &sync.Mutex{}
+		client.evictionLocks[info.signer] = lock
+	}
+	client.mtx.Unlock()
+
+	lock.Lock()
+	return lock.Unlock, nil
+}
+
 // handleEvictions handles the scenario where a transaction is evicted from the mempool.
 // It removes the evicted transaction from the local tx tracker without incrementing
 // the signer's sequence.
@@ -487,15 +714,141 @@ func (client *TxClient) handleEvictions(txHash string) error {
 	if err := client.signer.SetSequence(txInfo.signer, txInfo.sequence); err != nil {
 		return fmt.Errorf("setting sequence: %w", err)
 	}
-	delete(client.txTracker, txHash)
-	return fmt.Errorf("tx was evicted from the mempool")
+	client.removeFromTxTracker(txHash)
+	return ErrTxEvicted
 }
 
 // deleteFromTxTracker safely deletes a transaction from the local tx tracker.
 func (client *TxClient) deleteFromTxTracker(txHash string) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
+	client.removeFromTxTracker(txHash)
+}
+
+// removeFromTxTracker deletes a transaction from the local tx tracker and the
+// journal. The caller must hold the lock. Journal deletions are best effort:
+// a stale entry is reconciled against the chain on the next replay.
+func (client *TxClient) removeFromTxTracker(txHash string) {
 	delete(client.txTracker, txHash)
+	if client.journal != nil {
+		_ = client.journal.Delete(txHash)
+	}
+}
+
+// replayJournal restores the local tx tracker from the journal. Every journaled
+// transaction is reconciled against its status on chain: committed and rejected
+// transactions are dropped, pending ones are tracked again and the sequence of
+// their signer is advanced past them, and evicted ones roll the sequence of
+// their signer back so that they can be resubmitted.
+func (client *TxClient) replayJournal(ctx context.Context) error {
+	entries, err := client.journal.Entries()
+	if err != nil {
+		return err
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	hashes := make([]string, 0, len(entries))
+	for hash, entry := range entries {
+		if err := client.checkAccountLoaded(ctx, entry.Signer); err != nil {
+			if !errors.Is(err, sdkerrors.ErrKeyNotFound) {
+				return fmt.Errorf("loading account of journaled tx %s: %w", hash, err)
+			}
+			// the account is no longer in the keyring
+			client.removeFromTxTracker(hash)
+			continue
+		}
+		hashes = append(hashes, hash)
+	}
+
+	statuses, err := client.txStatuses(ctx, hashes)
+	if err != nil {
+		return fmt.Errorf("querying status of journaled txs: %w", err)
+	}
+
+	// nextSequence tracks, per signer, the sequence the signer should resume
+	// from given the pending transactions.
+	nextSequence := make(map[string]uint64)
+	// evictedSequence tracks, per signer, the lowest sequence of an evicted
+	// transaction.
+	evictedSequence := make(map[string]uint64)
+	for i, hash := range hashes {
+		entry := entries[hash]
+		switch statuses[i].Status {
+		case core.TxStatusPending:
+			client.txTracker[hash] = txInfo{
+				sequence:  entry.Sequence,
+				signer:    entry.Signer,
+				timestamp: entry.Timestamp,
+			}
+			if entry.Sequence+1 > nextSequence[entry.Signer] {
+				nextSequence[entry.Signer] = entry.Sequence + 1
+			}
+		case core.TxStatusEvicted:
+			if seq, ok := evictedSequence[entry.Signer]; !ok || entry.Sequence < seq {
+				evictedSequence[entry.Signer] = entry.Sequence
+			}
+			client.removeFromTxTracker(hash)
+		default:
+			client.removeFromTxTracker(hash)
+		}
+	}
+
+	for signer, seq := range nextSequence {
+		if seq > client.signer.accounts[signer].Sequence() {
+			if err := client.signer.SetSequence(signer, seq); err != nil {
+				return fmt.Errorf("setting sequence: %w", err)
+			}
+		}
+	}
+
+	// All transactions with a later nonce than an evicted one will be kicked
+	// by the nodes tx pool so the sequence is rolled back to the evicted one.
+	for signer, seq := range evictedSequence {
+		if seq < client.signer.accounts[signer].Sequence() {
+			if err := client.signer.SetSequence(signer, seq); err != nil {
+				return fmt.Errorf("setting sequence: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// txStatuses returns the statuses of the transactions in the order of the
+// provided hashes, querying them in batches.
+func (client *TxClient) txStatuses(ctx context.Context, txHashes []string) ([]*tx.TxStatusResponse, error) {
+	return queryTxStatuses(ctx, tx.NewTxClient(client.grpc), txHashes)
+}
+
+// queryTxStatuses queries the statuses of the transactions in batches. Nodes
+// that don't support TxStatusBatch are queried one transaction at a time.
+func queryTxStatuses(ctx context.Context, txClient tx.TxClient, txHashes []string) ([]*tx.TxStatusResponse, error) {
+	statuses := make([]*tx.TxStatusResponse, 0, len(txHashes))
+	for start := 0; start < len(txHashes); start += tx.MaxTxStatusBatchSize {
+		end := min(start+tx.MaxTxStatusBatchSize, len(txHashes))
+		resp, err := txClient.TxStatusBatch(ctx, &tx.TxStatusBatchRequest{TxIds: txHashes[start:end]})
+		if status.Code(err) == codes.Unimplemented {
+			for _, txHash := range txHashes[start:] {
+				resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+				if err != nil {
+					return nil, err
+				}
+				statuses = append(statuses, resp)
+			}
+			return statuses, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Statuses) != end-start {
+			return nil, fmt.Errorf("expected %d tx statuses, got %d", end-start, len(resp.Statuses))
+		}
+		for _, result := range resp.Statuses {
+			statuses = append(statuses, result.Status)
+		}
+	}
+	return statuses, nil
 }
 
 // EstimateGas simulates the transaction, calculating the amount of gas that was consumed during execution. The final
@@ -2,27 +2,34 @@ package user_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
 	"testing"
 	"time"
 
-	"github.com/celestiaorg/celestia-app/v3/app/grpc/gasestimation"
-	"github.com/stretchr/testify/assert"
-
 	"github.com/celestiaorg/celestia-app/v3/app"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/gasestimation"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/pkg/user"
 	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+
+	"github.com/celestiaorg/go-square/v2/inclusion"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
-
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/libs/rand"
+	tmdb "github.com/tendermint/tm-db"
 )
 
 func TestTxClientTestSuite(t *testing.T) {
@@ -89,6 +96,40 @@ func (suite *TxClientTestSuite) TestSubmitPayForBlob() {
 	})
 }
 
+func (suite *TxClientTestSuite) TestBlobBatcher() {
+	t := suite.T()
+	cfg := user.DefaultBlobBatcherConfig()
+	cfg.FlushInterval = 500 * time.Millisecond
+	batcher, err := user.NewBlobBatcher(suite.txClient, cfg)
+	require.NoError(t, err)
+	batcher.Start(suite.ctx.GoContext())
+
+	blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 100, 200, 300, 400)
+	results := make([]<-chan user.BlobResult, len(blobs))
+	for i, blob := range blobs {
+		results[i] = batcher.Enqueue(suite.ctx.GoContext(), blob)
+	}
+	batcher.Stop()
+
+	var txHash string
+	for i, ch := range results {
+		res := <-ch
+		require.NoError(t, res.Err)
+		require.Greater(t, res.Height, int64(0))
+		expectedCommitment, err := inclusion.CreateCommitment(blobs[i], merkle.HashFromByteSlices, appconsts.DefaultSubtreeRootThreshold)
+		require.NoError(t, err)
+		require.Equal(t, expectedCommitment, res.Commitment)
+		// all blobs fit in a single PFB
+		if txHash == "" {
+			txHash = res.TxHash
+		}
+		require.Equal(t, txHash, res.TxHash)
+	}
+
+	_, err = batcher.Submit(suite.ctx.GoContext(), blobs[0])
+	require.ErrorIs(t, err, user.ErrBatcherStopped)
+}
+
 func (suite *TxClientTestSuite) TestSubmitTx() {
 	t := suite.T()
 	gasLimit := uint64(1e6)
@@ -147,6 +188,94 @@ func (suite *TxClientTestSuite) TestSubmitTx() {
 	})
 }
 
+func (suite *TxClientTestSuite) TestSubmitTxWithEstimatedGasPrice() {
+	t := suite.T()
+	maxGasPrice := appconsts.DefaultMinGasPrice * 2
+	txClient, err := user.SetupTxClient(suite.ctx.GoContext(), suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg,
+		user.WithDefaultAccount("b"),
+		user.WithEstimatorPriority(gasestimation.TxPriority_TX_PRIORITY_HIGH),
+		user.WithMaxGasPrice(maxGasPrice),
+		user.WithFeeReplacement(2, 1.5),
+	)
+	require.NoError(t, err)
+
+	gasLimit := uint64(1e5)
+	msg := bank.NewMsgSend(txClient.DefaultAddress(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+	resp, err := txClient.SubmitTx(suite.ctx.GoContext(), []sdk.Msg{msg}, user.SetGasLimit(gasLimit))
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+
+	getTxResp, err := suite.serviceClient.GetTx(suite.ctx.GoContext(), &sdktx.GetTxRequest{Hash: resp.TxHash})
+	require.NoError(t, err)
+	fee := getTxResp.Tx.AuthInfo.Fee.Amount.AmountOf(app.BondDenom).Int64()
+	require.LessOrEqual(t, fee, int64(math.Ceil(maxGasPrice*float64(gasLimit))))
+
+	// the account is shared with other tests so resync it
+	seq := txClient.Signer().Account("b").Sequence()
+	require.NoError(t, suite.txClient.Signer().SetSequence("b", seq))
+}
+
+func (suite *TxClientTestSuite) TestMaxGasPriceBelowNetworkMinGasPrice() {
+	_, err := user.SetupTxClient(suite.ctx.GoContext(), suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg,
+		user.WithMaxGasPrice(appconsts.DefaultNetworkMinGasPrice/2),
+	)
+	suite.Require().ErrorContains(err, "less than the network min gas price")
+}
+
+func (suite *TxClientTestSuite) TestConfirmTxWithStatusStream() {
+	t := suite.T()
+	// the poll time is long enough that confirmations can only come from the stream
+	txClient, err := user.SetupTxClient(suite.ctx.GoContext(), suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg,
+		user.WithDefaultAccount("c"),
+		user.WithPollTime(time.Hour),
+		user.WithTxStatusStream(),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(suite.ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	t.Run("confirms concurrent transactions", func(t *testing.T) {
+		numTxs := 5
+		txHashes := make([]string, numTxs)
+		for i := range txHashes {
+			blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 1e3)
+			resp, err := txClient.BroadcastPayForBlob(ctx, blobs)
+			require.NoError(t, err)
+			txHashes[i] = resp.TxHash
+		}
+
+		var wg sync.WaitGroup
+		errs := make(chan error, numTxs)
+		for _, txHash := range txHashes {
+			wg.Add(1)
+			go func(txHash string) {
+				defer wg.Done()
+				resp, err := txClient.ConfirmTx(ctx, txHash)
+				if err == nil && resp.Height == 0 {
+					err = fmt.Errorf("tx %s confirmed without height", txHash)
+				}
+				errs <- err
+			}(txHash)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("errors when tx is not found", func(t *testing.T) {
+		_, err := txClient.ConfirmTx(ctx, "E32BD15CAF57AF15D17B0D63CF4E63A9835DD1CEBB059C335C79586BC3013728")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not found; it was likely rejected")
+	})
+
+	// the account is shared with other tests so resync it
+	seq := txClient.Signer().Account("c").Sequence()
+	require.NoError(t, suite.txClient.Signer().SetSequence("c", seq))
+}
+
 func (suite *TxClientTestSuite) TestConfirmTx() {
 	t := suite.T()
 
@@ -255,6 +384,108 @@ func TestEvictions(t *testing.T) {
 	require.Equal(t, seqBeforeEviction, seqAfterEviction)
 }
 
+func TestEvictionsWithRetryPolicy(t *testing.T) {
+	// every broadcast transaction, including the resubmitted ones, is journaled
+	journal := &recordingJournal{TxJournal: user.NewTxJournal(tmdb.NewMemDB()), signers: make(map[string]bool)}
+	// transactions expire from the mempool right away so they get evicted
+	_, txClient, ctx := setupTxClient(t, 1*time.Nanosecond,
+		user.WithTxJournal(journal),
+		user.WithRetryPolicy(user.RetryPolicy{
+			MaxAttempts:        2,
+			GasPriceBumpFactor: 1.5,
+		}),
+	)
+
+	sender := txClient.Signer().Account(txClient.DefaultAccountName())
+	msg := bank.NewMsgSend(sender.Address(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+	submissions := 0
+	for ; submissions < 10 && journal.numPuts() == submissions; submissions++ {
+		seqBeforeSubmission := sender.Sequence()
+		_, err := txClient.SubmitTx(ctx.GoContext(), []sdk.Msg{msg}, user.SetFee(1e6), user.SetGasLimit(1e6))
+		if err != nil {
+			// the tx was resubmitted at the same sequence until the policy was exhausted
+			require.ErrorIs(t, err, user.ErrTxEvicted)
+			require.Equal(t, seqBeforeSubmission, sender.Sequence())
+		}
+	}
+	require.Greater(t, journal.numPuts(), submissions, "no evicted tx was resubmitted")
+}
+
+// recordingJournal records the signers and the number of the journaled
+// transactions.
+type recordingJournal struct {
+	user.TxJournal
+
+	mtx     sync.Mutex
+	signers map[string]bool
+	puts    int
+}
+
+func (j *recordingJournal) Put(txHash string, entry user.JournalEntry) error {
+	j.mtx.Lock()
+	j.signers[entry.Signer] = true
+	j.puts++
+	j.mtx.Unlock()
+	return j.TxJournal.Put(txHash, entry)
+}
+
+func (j *recordingJournal) numPuts() int {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	return j.puts
+}
+
+func TestSubmitPayForBlobWithLanes(t *testing.T) {
+	numLanes, numBlobs := 3, 6
+	journal := &recordingJournal{TxJournal: user.NewTxJournal(tmdb.NewMemDB()), signers: make(map[string]bool)}
+	_, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration,
+		user.WithDefaultAccount("a"),
+		user.WithLanes(user.LaneConfig{Accounts: numLanes, CreateAccounts: true, FeeSpendLimit: 1e9}),
+		user.WithTxJournal(journal),
+	)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, numBlobs)
+	for i := 0; i < numBlobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 1e3)
+			_, err := txClient.SubmitPayForBlob(ctx.GoContext(), blobs)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	stats := txClient.SubmissionStats()
+	require.Len(t, stats.Lanes, numLanes)
+	require.EqualValues(t, numBlobs, stats.Submitted)
+	require.EqualValues(t, numBlobs, stats.Confirmed)
+	require.Zero(t, stats.Failed)
+	for _, lane := range stats.Lanes {
+		require.Equal(t, lane.Submitted, lane.Sequence)
+		if lane.Submitted > 0 {
+			// the lanes are created with the options of the client
+			require.True(t, journal.signers[lane.Account], "txs of lane %s were not journaled", lane.Account)
+		}
+
+		resp, err := feegrant.NewQueryClient(ctx.GRPCClient).Allowance(ctx.GoContext(), &feegrant.QueryAllowanceRequest{
+			Granter: txClient.DefaultAddress().String(),
+			Grantee: lane.Address.String(),
+		})
+		require.NoError(t, err)
+		var allowance feegrant.BasicAllowance
+		require.NoError(t, allowance.Unmarshal(resp.Allowance.Allowance.Value))
+		// the fees paid by the lane are deducted from the spend limit
+		require.False(t, allowance.SpendLimit.IsZero())
+		require.True(t, allowance.SpendLimit.IsAllLTE(sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1e9))))
+	}
+}
+
 func (suite *TxClientTestSuite) TestGasEstimation() {
 	addr := suite.txClient.DefaultAddress()
 	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
@@ -313,6 +544,69 @@ func (suite *TxClientTestSuite) TestTxClientWithDifferentDefaultAccount() {
 	suite.Equal(txClient.DefaultAddress(), addrC)
 }
 
+func (suite *TxClientTestSuite) TestTxJournalReplay() {
+	t := suite.T()
+	journal := user.NewTxJournal(tmdb.NewMemDB())
+	txClient, err := user.SetupTxClient(suite.ctx.GoContext(), suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg, user.WithTxJournal(journal))
+	require.NoError(t, err)
+
+	addr := txClient.DefaultAddress()
+	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+	resp, err := txClient.BroadcastTx(suite.ctx.GoContext(), []sdk.Msg{msg}, user.SetFee(1e6), user.SetGasLimit(1e6))
+	require.NoError(t, err)
+
+	entries, err := journal.Entries()
+	require.NoError(t, err)
+	require.Contains(t, entries, resp.TxHash)
+
+	// a new client replaying the journal picks up where the previous one left off
+	restarted, err := user.SetupTxClient(suite.ctx.GoContext(), suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg, user.WithTxJournal(journal))
+	require.NoError(t, err)
+	require.Equal(t,
+		txClient.Signer().Account(txClient.DefaultAccountName()).Sequence(),
+		restarted.Signer().Account(restarted.DefaultAccountName()).Sequence(),
+	)
+
+	_, err = restarted.ConfirmTx(suite.ctx.GoContext(), resp.TxHash)
+	require.NoError(t, err)
+	entries, err = journal.Entries()
+	require.NoError(t, err)
+	require.NotContains(t, entries, resp.TxHash)
+
+	// the default account is shared with the suite's client so resync it
+	seq := restarted.Signer().Account(restarted.DefaultAccountName()).Sequence()
+	require.NoError(t, suite.txClient.Signer().SetSequence(suite.txClient.DefaultAccountName(), seq))
+}
+
+// failingJournal fails to record transactions.
+type failingJournal struct {
+	user.TxJournal
+}
+
+func (failingJournal) Put(string, user.JournalEntry) error {
+	return errors.New("disk full")
+}
+
+func (suite *TxClientTestSuite) TestBroadcastTxWithFailingJournal() {
+	t := suite.T()
+	journal := failingJournal{TxJournal: user.NewTxJournal(tmdb.NewMemDB())}
+	txClient, err := user.SetupTxClient(suite.ctx.GoContext(), suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg, user.WithTxJournal(journal))
+	require.NoError(t, err)
+
+	// the broadcast succeeds even though the tx isn't journaled
+	addr := txClient.DefaultAddress()
+	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+	resp, err := txClient.BroadcastTx(suite.ctx.GoContext(), []sdk.Msg{msg}, user.SetFee(1e6), user.SetGasLimit(1e6))
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.TxHash)
+	_, err = txClient.ConfirmTx(suite.ctx.GoContext(), resp.TxHash)
+	require.NoError(t, err)
+
+	// the default account is shared with the suite's client so resync it
+	seq := txClient.Signer().Account(txClient.DefaultAccountName()).Sequence()
+	require.NoError(t, suite.txClient.Signer().SetSequence(suite.txClient.DefaultAccountName(), seq))
+}
+
 func (suite *TxClientTestSuite) queryCurrentBalance(t *testing.T) int64 {
 	balanceQuery := bank.NewQueryClient(suite.ctx.GRPCClient)
 	addr := suite.txClient.DefaultAddress()
@@ -338,7 +632,7 @@ func assertTxInTxTracker(t *testing.T, txClient *user.TxClient, txHash string, e
 	require.Equal(t, seqAfterBroadcast, seqBeforeBroadcast+1)
 }
 
-func setupTxClient(t *testing.T, ttlDuration time.Duration) (encoding.Config, *user.TxClient, testnode.Context) {
+func setupTxClient(t *testing.T, ttlDuration time.Duration, opts ...user.Option) (encoding.Config, *user.TxClient, testnode.Context) {
 	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 	defaultTmConfig := testnode.DefaultTendermintConfig()
 	defaultTmConfig.Mempool.TTLDuration = ttlDuration
@@ -349,7 +643,7 @@ func setupTxClient(t *testing.T, ttlDuration time.Duration) (encoding.Config, *u
 	ctx, _, _ := testnode.NewNetwork(t, testnodeConfig)
 	_, err := ctx.WaitForHeight(1)
 	require.NoError(t, err)
-	txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg, user.WithGasMultiplier(1.2))
+	txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg, append([]user.Option{user.WithGasMultiplier(1.2)}, opts...)...)
 	require.NoError(t, err)
 	return encCfg, txClient, ctx
 }
@@ -0,0 +1,418 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/rpc/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
+)
+
+const (
+	// txStatusStreamReconnectDelay is the time waited before reopening a
+	// stream that ended because of a transient error.
+	txStatusStreamReconnectDelay = time.Second
+	// maxTxStatusStreamReconnects is the number of times in a row a stream is
+	// reopened without receiving any event before its watchers are failed.
+	maxTxStatusStreamReconnects = 5
+)
+
+// errTxStatusStreamEnded is the error of a stream that the node ended while
+// transactions were still watched.
+var errTxStatusStreamEnded = errors.New("tx status stream ended unexpectedly")
+
+// WithTxStatusStream makes the client confirm transactions over a single
+// SubscribeTxStatus stream shared by all in-flight transactions instead of
+// polling TxStatus for each of them. Lanes share the stream of their parent
+// client. The stream is reopened if it fails because of a transient error,
+// and the client falls back to polling if the node doesn't implement
+// SubscribeTxStatus.
+func WithTxStatusStream() Option {
+	return func(c *TxClient) {
+		c.statusStream = newTxStatusStream(tx.NewTxClient(c.grpc))
+	}
+}
+
+// txStatusUpdate is a status of a transaction received over a
+// SubscribeTxStatus stream or the error that terminated the stream.
+type txStatusUpdate struct {
+	status *tx.TxStatusResponse
+	err    error
+}
+
+// txStatusStream multiplexes the status updates of all watched transactions
+// over a single SubscribeTxStatus stream. Every watched transaction is sent
+// on the open stream, to which the node replies with its current status, so
+// the stream is opened once and kept open while transactions are watched.
+// txStatusStream is thread-safe.
+type txStatusStream struct {
+	client         tx.TxClient
+	reconnectDelay time.Duration
+
+	mtx sync.Mutex
+	// watchers maps the hash of every watched transaction to the channels of
+	// its watchers. Each channel only holds the latest update.
+	watchers map[string][]chan txStatusUpdate
+	// sub is the open stream, if any.
+	sub *txStatusSubscription
+	// reconnects is the number of times in a row the stream was reopened
+	// without receiving any event.
+	reconnects int
+	// unsupported is set once the node turns out not to implement
+	// SubscribeTxStatus.
+	unsupported bool
+
+	// sendMtx serializes the requests sent on the stream.
+	sendMtx sync.Mutex
+}
+
+// txStatusSubscription is an open SubscribeTxStatus stream.
+type txStatusSubscription struct {
+	stream tx.Tx_SubscribeTxStatusClient
+	cancel context.CancelFunc
+	// final holds the transactions that reached a final status on the stream.
+	final map[string]bool
+}
+
+func newTxStatusStream(client tx.TxClient) *txStatusStream {
+	return &txStatusStream{
+		client:         client,
+		reconnectDelay: txStatusStreamReconnectDelay,
+		watchers:       make(map[string][]chan txStatusUpdate),
+	}
+}
+
+// watch starts watching a transaction and returns the channel on which its
+// status updates are delivered. Every call must be followed by a call to
+// unwatch with the returned channel.
+func (s *txStatusStream) watch(txHash string) chan txStatusUpdate {
+	ch := make(chan txStatusUpdate, 1)
+
+	s.mtx.Lock()
+	s.watchers[txHash] = append(s.watchers[txHash], ch)
+	sub, err := s.open()
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			s.unsupported = true
+		}
+		s.mtx.Unlock()
+		ch <- txStatusUpdate{err: err}
+		return ch
+	}
+	delete(sub.final, txHash)
+	s.mtx.Unlock()
+
+	// the transaction is sent even if it is already watched so that the node
+	// sends its current status to the new watcher.
+	s.sendMtx.Lock()
+	err = sub.stream.Send(&tx.SubscribeTxStatusRequest{TxIds: []string{txHash}})
+	s.sendMtx.Unlock()
+	if err != nil {
+		s.fail(sub, err)
+	}
+	return ch
+}
+
+// unwatch stops delivering the status updates of a transaction to the
+// channel. The stream is closed once no transaction is watched anymore.
+func (s *txStatusStream) unwatch(txHash string, ch chan txStatusUpdate) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	watchers := s.watchers[txHash]
+	for i, watcher := range watchers {
+		if watcher == ch {
+			watchers = append(watchers[:i], watchers[i+1:]...)
+			break
+		}
+	}
+	if len(watchers) == 0 {
+		delete(s.watchers, txHash)
+	} else {
+		s.watchers[txHash] = watchers
+	}
+
+	if len(s.watchers) == 0 && s.sub != nil {
+		s.sub.cancel()
+		s.sub = nil
+	}
+}
+
+// open returns the open stream, opening one if needed. The caller must hold
+// the lock.
+func (s *txStatusStream) open() (*txStatusSubscription, error) {
+	if s.sub != nil {
+		return s.sub, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := s.client.SubscribeTxStatus(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	s.sub = &txStatusSubscription{stream: stream, cancel: cancel, final: make(map[string]bool)}
+	go s.receive(ctx, s.sub)
+	return s.sub, nil
+}
+
+// receive delivers the events of the stream to the watchers until the stream
+// ends or is closed.
+func (s *txStatusStream) receive(ctx context.Context, sub *txStatusSubscription) {
+	for {
+		event, err := sub.stream.Recv()
+		if ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, io.EOF) {
+			err = errTxStatusStreamEnded
+		}
+		if err != nil {
+			s.fail(sub, err)
+			return
+		}
+		s.deliver(sub, event.TxId, txStatusUpdate{status: event.Status})
+	}
+}
+
+// fail closes the stream. If the stream failed because of a transient error,
+// it is reopened after the reconnect delay. Otherwise, or if it was reopened
+// too many times in a row, the error is delivered to the watchers of the
+// transactions that haven't reached a final status on it.
+func (s *txStatusStream) fail(sub *txStatusSubscription, err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.sub != sub {
+		return
+	}
+	sub.cancel()
+	s.sub = nil
+	if status.Code(err) == codes.Unimplemented {
+		s.unsupported = true
+	}
+	if isTransientStreamError(err) && s.reconnects < maxTxStatusStreamReconnects {
+		s.reconnects++
+		go s.reconnect(sub.final)
+		return
+	}
+	s.reconnects = 0
+	s.failWatchers(sub.final, err)
+}
+
+// reconnect reopens the stream after the reconnect delay and sends on it the
+// watched transactions that haven't reached a final status. A stream opened
+// by a new watcher in the meantime is reused.
+func (s *txStatusStream) reconnect(final map[string]bool) {
+	time.Sleep(s.reconnectDelay)
+
+	s.mtx.Lock()
+	if len(s.watchers) == 0 {
+		s.mtx.Unlock()
+		return
+	}
+	sub, err := s.open()
+	if err != nil {
+		if isTransientStreamError(err) && s.reconnects < maxTxStatusStreamReconnects {
+			s.reconnects++
+			go s.reconnect(final)
+		} else {
+			s.reconnects = 0
+			s.failWatchers(final, err)
+		}
+		s.mtx.Unlock()
+		return
+	}
+	var txHashes []string
+	for txHash := range s.watchers {
+		if final[txHash] {
+			sub.final[txHash] = true
+		} else if !sub.final[txHash] {
+			txHashes = append(txHashes, txHash)
+		}
+	}
+	s.mtx.Unlock()
+
+	s.sendMtx.Lock()
+	err = sub.stream.Send(&tx.SubscribeTxStatusRequest{TxIds: txHashes})
+	s.sendMtx.Unlock()
+	if err != nil {
+		s.fail(sub, err)
+	}
+}
+
+// failWatchers delivers the error to the watchers of the transactions that
+// haven't reached a final status. The caller must hold the lock.
+func (s *txStatusStream) failWatchers(final map[string]bool, err error) {
+	for txHash := range s.watchers {
+		if !final[txHash] {
+			s.send(txHash, txStatusUpdate{err: err})
+		}
+	}
+}
+
+// isTransientStreamError returns true if a stream that failed with the error
+// is worth reopening.
+func isTransientStreamError(err error) bool {
+	if errors.Is(err, errTxStatusStreamEnded) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// isUnsupported returns true if the node doesn't implement SubscribeTxStatus.
+func (s *txStatusStream) isUnsupported() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.unsupported
+}
+
+// deliver sends an update of the stream to the watchers of a transaction.
+// Updates of a stream that has been closed are dropped.
+func (s *txStatusStream) deliver(sub *txStatusSubscription, txHash string, update txStatusUpdate) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.sub != sub {
+		return
+	}
+	s.reconnects = 0
+	if update.status.Status != core.TxStatusPending {
+		sub.final[txHash] = true
+	}
+	s.send(txHash, update)
+}
+
+// send sends an update to the watchers of a transaction, replacing any update
+// they haven't received yet. The caller must hold the lock.
+func (s *txStatusStream) send(txHash string, update txStatusUpdate) {
+	for _, ch := range s.watchers[txHash] {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- update
+	}
+}
+
+// txStatusWatcher returns the successive statuses of a transaction that is
+// being confirmed. The hash changes between calls when an evicted transaction
+// is resubmitted or when a pending transaction is replaced by one with a
+// higher fee (see WithFeeReplacement).
+type txStatusWatcher interface {
+	// next returns the status of the transaction. Unless the hash changed,
+	// it waits for the status to be updated or for the poll time to elapse.
+	next(ctx context.Context, txHash string) (*tx.TxStatusResponse, error)
+	// stop releases the resources of the watcher.
+	stop()
+}
+
+// newTxStatusWatcher returns a watcher using the status stream of the client
+// if it has one and the node supports it, or polling TxStatus otherwise.
+func (client *TxClient) newTxStatusWatcher() txStatusWatcher {
+	if client.statusStream != nil && !client.statusStream.isUnsupported() {
+		return &streamTxStatusWatcher{stream: client.statusStream, pollTime: client.pollTime}
+	}
+	return newPollingTxStatusWatcher(tx.NewTxClient(client.grpc), client.pollTime)
+}
+
+func newPollingTxStatusWatcher(client tx.TxClient, pollTime time.Duration) *pollingTxStatusWatcher {
+	return &pollingTxStatusWatcher{client: client, ticker: time.NewTicker(pollTime)}
+}
+
+// pollingTxStatusWatcher queries TxStatus every poll time.
+type pollingTxStatusWatcher struct {
+	client tx.TxClient
+	ticker *time.Ticker
+	txHash string
+}
+
+func (w *pollingTxStatusWatcher) next(ctx context.Context, txHash string) (*tx.TxStatusResponse, error) {
+	if txHash == w.txHash {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-w.ticker.C:
+		}
+	}
+	w.txHash = txHash
+	return w.client.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+}
+
+func (w *pollingTxStatusWatcher) stop() {
+	w.ticker.Stop()
+}
+
+// streamTxStatusWatcher receives the status updates of a transaction from
+// the status stream of the client. If the status doesn't change within the
+// poll time, the last status is returned again so that confirmTx can check
+// how long a transaction has been pending when fee replacement is enabled.
+// If the node doesn't implement SubscribeTxStatus, the watcher falls back to
+// polling TxStatus.
+type streamTxStatusWatcher struct {
+	stream   *txStatusStream
+	pollTime time.Duration
+	txHash   string
+	updates  chan txStatusUpdate
+	last     *tx.TxStatusResponse
+	// polling is the watcher used once the stream turned out to be
+	// unsupported.
+	polling *pollingTxStatusWatcher
+}
+
+func (w *streamTxStatusWatcher) next(ctx context.Context, txHash string) (*tx.TxStatusResponse, error) {
+	if w.polling != nil {
+		return w.polling.next(ctx, txHash)
+	}
+	if txHash != w.txHash {
+		w.stop()
+		w.txHash = txHash
+		w.updates = w.stream.watch(txHash)
+		w.last = nil
+	}
+
+	var timeout <-chan time.Time
+	if w.last != nil {
+		timer := time.NewTimer(w.pollTime)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case update := <-w.updates:
+		if status.Code(update.err) == codes.Unimplemented {
+			w.stop()
+			w.polling = newPollingTxStatusWatcher(w.stream.client, w.pollTime)
+			return w.polling.next(ctx, txHash)
+		}
+		if update.err != nil {
+			return nil, update.err
+		}
+		w.last = update.status
+		return update.status, nil
+	case <-timeout:
+		return w.last, nil
+	}
+}
+
+func (w *streamTxStatusWatcher) stop() {
+	if w.updates != nil {
+		w.stream.unwatch(w.txHash, w.updates)
+		w.updates = nil
+	}
+	if w.polling != nil {
+		w.polling.stop()
+	}
+}
@@ -0,0 +1,262 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
+)
+
+// mockTxStatusClient opens streams whose events are sent by the test.
+type mockTxStatusClient struct {
+	tx.TxClient
+
+	mtx      sync.Mutex
+	requests []*tx.SubscribeTxStatusRequest
+	streams  []chan *tx.TxStatusEvent
+}
+
+func (c *mockTxStatusClient) SubscribeTxStatus(ctx context.Context, _ ...grpc.CallOption) (tx.Tx_SubscribeTxStatusClient, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	events := make(chan *tx.TxStatusEvent, 1)
+	c.streams = append(c.streams, events)
+	return &mockTxStatusStream{ctx: ctx, client: c, events: events}, nil
+}
+
+// send waits for the given number of requests to be sent and sends the event
+// on the last stream.
+func (c *mockTxStatusClient) send(t *testing.T, requests int, event *tx.TxStatusEvent) {
+	require.Eventually(t, func() bool {
+		c.mtx.Lock()
+		defer c.mtx.Unlock()
+		return len(c.requests) == requests
+	}, time.Second, time.Millisecond)
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.streams[len(c.streams)-1] <- event
+}
+
+func (c *mockTxStatusClient) numStreams() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return len(c.streams)
+}
+
+func (c *mockTxStatusClient) txIDs() []string {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	var txIDs []string
+	for _, req := range c.requests {
+		txIDs = append(txIDs, req.TxIds...)
+	}
+	return txIDs
+}
+
+type mockTxStatusStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	client *mockTxStatusClient
+	events chan *tx.TxStatusEvent
+}
+
+func (s *mockTxStatusStream) Send(req *tx.SubscribeTxStatusRequest) error {
+	s.client.mtx.Lock()
+	defer s.client.mtx.Unlock()
+	s.client.requests = append(s.client.requests, req)
+	return nil
+}
+
+func (s *mockTxStatusStream) Recv() (*tx.TxStatusEvent, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	case event, ok := <-s.events:
+		if !ok {
+			return nil, io.EOF
+		}
+		return event, nil
+	}
+}
+
+func TestTxStatusStream(t *testing.T) {
+	client := &mockTxStatusClient{}
+	stream := newTxStatusStream(client)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	first := &streamTxStatusWatcher{stream: stream, pollTime: time.Hour}
+	defer first.stop()
+	second := &streamTxStatusWatcher{stream: stream, pollTime: time.Hour}
+	defer second.stop()
+
+	// watching a transaction adds it to the open stream
+	go client.send(t, 1, &tx.TxStatusEvent{TxId: "a", Status: &tx.TxStatusResponse{Status: core.TxStatusPending}})
+	resp, err := first.next(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, core.TxStatusPending, resp.Status)
+
+	go client.send(t, 2, &tx.TxStatusEvent{TxId: "b", Status: &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: 2}})
+	resp, err = second.next(ctx, "b")
+	require.NoError(t, err)
+	require.Equal(t, core.TxStatusCommitted, resp.Status)
+	require.Equal(t, []string{"a", "b"}, client.txIDs())
+	require.Equal(t, 1, client.numStreams())
+
+	go client.send(t, 2, &tx.TxStatusEvent{TxId: "a", Status: &tx.TxStatusResponse{Status: core.TxStatusEvicted}})
+	resp, err = first.next(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, core.TxStatusEvicted, resp.Status)
+
+	// the stream is closed once nothing is watched anymore
+	first.stop()
+	second.stop()
+	stream.mtx.Lock()
+	require.Empty(t, stream.watchers)
+	require.Nil(t, stream.sub)
+	stream.mtx.Unlock()
+}
+
+func TestTxStatusStreamEnded(t *testing.T) {
+	client := &mockTxStatusClient{}
+	stream := newTxStatusStream(client)
+	stream.reconnectDelay = time.Millisecond
+	committed := &streamTxStatusWatcher{stream: stream, pollTime: time.Hour}
+	defer committed.stop()
+	pending := &streamTxStatusWatcher{stream: stream, pollTime: time.Hour}
+	defer pending.stop()
+
+	go client.send(t, 1, &tx.TxStatusEvent{TxId: "a", Status: &tx.TxStatusResponse{Status: core.TxStatusCommitted}})
+	_, err := committed.next(context.Background(), "a")
+	require.NoError(t, err)
+	go client.send(t, 2, &tx.TxStatusEvent{TxId: "b", Status: &tx.TxStatusResponse{Status: core.TxStatusPending}})
+	_, err = pending.next(context.Background(), "b")
+	require.NoError(t, err)
+
+	// the stream is reopened with the transactions without a final status
+	client.mtx.Lock()
+	close(client.streams[0])
+	client.mtx.Unlock()
+	go client.send(t, 3, &tx.TxStatusEvent{TxId: "b", Status: &tx.TxStatusResponse{Status: core.TxStatusCommitted}})
+	resp, err := pending.next(context.Background(), "b")
+	require.NoError(t, err)
+	require.Equal(t, core.TxStatusCommitted, resp.Status)
+	require.Equal(t, []string{"a", "b", "b"}, client.txIDs())
+	require.Equal(t, 2, client.numStreams())
+	select {
+	case update := <-committed.updates:
+		t.Fatalf("unexpected update %v", update)
+	default:
+	}
+}
+
+func TestTxStatusStreamReconnectsExhausted(t *testing.T) {
+	client := &mockTxStatusClient{}
+	stream := newTxStatusStream(client)
+	stream.reconnectDelay = time.Millisecond
+	watcher := &streamTxStatusWatcher{stream: stream, pollTime: time.Hour}
+	defer watcher.stop()
+
+	go client.send(t, 1, &tx.TxStatusEvent{TxId: "a", Status: &tx.TxStatusResponse{Status: core.TxStatusPending}})
+	_, err := watcher.next(context.Background(), "a")
+	require.NoError(t, err)
+
+	// the watchers are failed once the stream keeps ending without events
+	for i := 0; i <= maxTxStatusStreamReconnects; i++ {
+		require.Eventually(t, func() bool { return client.numStreams() == i+1 }, time.Second, time.Millisecond)
+		client.mtx.Lock()
+		close(client.streams[i])
+		client.mtx.Unlock()
+	}
+	_, err = watcher.next(context.Background(), "a")
+	require.ErrorIs(t, err, errTxStatusStreamEnded)
+}
+
+func TestTxStatusStreamUnimplemented(t *testing.T) {
+	client := &unimplementedTxStatusClient{}
+	stream := newTxStatusStream(client)
+	watcher := &streamTxStatusWatcher{stream: stream, pollTime: time.Hour}
+	defer watcher.stop()
+
+	// the watcher falls back to polling TxStatus
+	resp, err := watcher.next(context.Background(), "a")
+	require.NoError(t, err)
+	require.Equal(t, core.TxStatusCommitted, resp.Status)
+	require.True(t, stream.isUnsupported())
+
+	txClient := &TxClient{statusStream: stream, pollTime: time.Hour}
+	newWatcher := txClient.newTxStatusWatcher()
+	defer newWatcher.stop()
+	require.IsType(t, &pollingTxStatusWatcher{}, newWatcher)
+}
+
+// unimplementedTxStatusClient is a node that doesn't implement
+// SubscribeTxStatus.
+type unimplementedTxStatusClient struct {
+	tx.TxClient
+}
+
+func (c *unimplementedTxStatusClient) SubscribeTxStatus(context.Context, ...grpc.CallOption) (tx.Tx_SubscribeTxStatusClient, error) {
+	return &unimplementedTxStatusStream{}, nil
+}
+
+func (c *unimplementedTxStatusClient) TxStatus(context.Context, *tx.TxStatusRequest, ...grpc.CallOption) (*tx.TxStatusResponse, error) {
+	return &tx.TxStatusResponse{Status: core.TxStatusCommitted}, nil
+}
+
+type unimplementedTxStatusStream struct {
+	grpc.ClientStream
+}
+
+func (s *unimplementedTxStatusStream) Send(*tx.SubscribeTxStatusRequest) error {
+	return nil
+}
+
+func (s *unimplementedTxStatusStream) Recv() (*tx.TxStatusEvent, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown method SubscribeTxStatus")
+}
+
+func TestStreamTxStatusWatcherTimeout(t *testing.T) {
+	client := &mockTxStatusClient{}
+	watcher := &streamTxStatusWatcher{stream: newTxStatusStream(client), pollTime: 10 * time.Millisecond}
+	defer watcher.stop()
+
+	go client.send(t, 1, &tx.TxStatusEvent{TxId: "a", Status: &tx.TxStatusResponse{Status: core.TxStatusPending}})
+	resp, err := watcher.next(context.Background(), "a")
+	require.NoError(t, err)
+	require.Equal(t, core.TxStatusPending, resp.Status)
+
+	// without an update, the last status is returned after the poll time
+	resp, err = watcher.next(context.Background(), "a")
+	require.NoError(t, err)
+	require.Equal(t, core.TxStatusPending, resp.Status)
+}
+
+func TestTxStatusStreamError(t *testing.T) {
+	streamErr := errors.New("stream error")
+	stream := newTxStatusStream(&failingTxStatusClient{err: streamErr})
+	watcher := &streamTxStatusWatcher{stream: stream, pollTime: time.Hour}
+	defer watcher.stop()
+
+	_, err := watcher.next(context.Background(), "a")
+	require.ErrorIs(t, err, streamErr)
+}
+
+type failingTxStatusClient struct {
+	tx.TxClient
+	err error
+}
+
+func (c *failingTxStatusClient) SubscribeTxStatus(context.Context, ...grpc.CallOption) (tx.Tx_SubscribeTxStatusClient, error) {
+	return nil, c.err
+}
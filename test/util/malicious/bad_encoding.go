@@ -0,0 +1,34 @@
+package malicious
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// ExtendSharesWithBadEncoding extends the shares and then corrupts the share
+// of the extended data square at (row, col), leaving its namespace untouched.
+// The row and column of that share are thus no longer the extension of their
+// first half. The returned square computes its roots using the malicious tree
+// so that the data availability header of the badly encoded square can be
+// computed.
+func ExtendSharesWithBadEncoding(s [][]byte, row, col uint) (*rsmt2d.ExtendedDataSquare, error) {
+	eds, err := ExtendShares(s)
+	if err != nil {
+		return nil, err
+	}
+	width := eds.Width()
+	if row >= width || col >= width {
+		return nil, fmt.Errorf("coordinate (%d, %d) out of bounds of an extended data square of width %d", row, col, width)
+	}
+
+	shares := eds.Flattened()
+	corrupted := append([]byte{}, shares[row*width+col]...)
+	for i := share.NamespaceSize; i < len(corrupted); i++ {
+		corrupted[i] ^= 0xff
+	}
+	shares[row*width+col] = corrupted
+	return rsmt2d.ImportExtendedDataSquare(shares, appconsts.DefaultCodec(), NewConstructor(uint64(width/2)))
+}
@@ -34,6 +34,7 @@ func (a *App) OutOfOrderPrepareProposal(req abci.RequestPrepareProposal) abci.Re
 		a.AccountKeeper,
 		a.BankKeeper,
 		a.BlobKeeper,
+		a.NamespaceKeeper,
 		a.FeeGrantKeeper,
 		a.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -0,0 +1,55 @@
+package ante
+
+import (
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NamespaceKeeper is the subset of the namespace keeper used to look up the
+// writers of registered namespaces.
+type NamespaceKeeper interface {
+	IsWriter(ctx sdk.Context, namespace []byte, signer string) bool
+}
+
+// NamespaceWritersDecorator rejects a PFB that pays for a blob in a registered
+// namespace if the signer of the PFB is neither the owner nor one of the
+// writers of the namespace. It applies in CheckTx, ProcessProposal and
+// DeliverTx so that only PFBs accepted by the registry are included in a
+// block. Only applies to app version >= 4 because namespaces can't be
+// registered before.
+type NamespaceWritersDecorator struct {
+	k NamespaceKeeper
+}
+
+func NewNamespaceWritersDecorator(k NamespaceKeeper) NamespaceWritersDecorator {
+	return NamespaceWritersDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. It
+// returns an error if tx contains a MsgPayForBlobs whose signer isn't allowed
+// to write to one of its namespaces.
+func (d NamespaceWritersDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeader().Version.App < v4.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	// The registrations are looked up without consuming gas so that the gas
+	// used by a PFB, and thus its gas estimation, doesn't depend on whether
+	// its namespaces are registered.
+	lookupCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, m := range tx.GetMsgs() {
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			for _, namespace := range pfb.Namespaces {
+				if !d.k.IsWriter(lookupCtx, namespace, pfb.Signer) {
+					return ctx, errors.Wrapf(namespacetypes.ErrUnauthorizedWriter, "signer %s, namespace %X", pfb.Signer, namespace)
+				}
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,99 @@
+package ante_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	ante "github.com/celestiaorg/celestia-app/v3/x/blob/ante"
+	blob "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	namespacetypes "github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	version "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestNamespaceWritersDecorator(t *testing.T) {
+	owner, writer, other := testnode.RandomAddress().String(), testnode.RandomAddress().String(), testnode.RandomAddress().String()
+	registered := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	unregistered := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	keeper := mockNamespaceKeeper{
+		string(registered.Bytes()): {Namespace: registered.Bytes(), Owner: owner, Writers: []string{writer}},
+	}
+
+	testCases := []struct {
+		name       string
+		signer     string
+		namespaces []share.Namespace
+		appVersion uint64
+		wantErr    error
+	}{
+		{
+			name:       "owner of a registered namespace",
+			signer:     owner,
+			namespaces: []share.Namespace{registered},
+			appVersion: v4.Version,
+		},
+		{
+			name:       "writer of a registered namespace",
+			signer:     writer,
+			namespaces: []share.Namespace{registered, unregistered},
+			appVersion: v4.Version,
+		},
+		{
+			name:       "anyone can write to an unregistered namespace",
+			signer:     other,
+			namespaces: []share.Namespace{unregistered},
+			appVersion: v4.Version,
+		},
+		{
+			name:       "other signer of a registered namespace",
+			signer:     other,
+			namespaces: []share.Namespace{unregistered, registered},
+			appVersion: v4.Version,
+			wantErr:    namespacetypes.ErrUnauthorizedWriter,
+		},
+		{
+			name:       "namespaces can't be registered before v4",
+			signer:     other,
+			namespaces: []share.Namespace{registered},
+			appVersion: v3.Version,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &blob.MsgPayForBlobs{Signer: tc.signer}
+			for _, ns := range tc.namespaces {
+				msg.Namespaces = append(msg.Namespaces, ns.Bytes())
+			}
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(msg))
+
+			decorator := ante.NewNamespaceWritersDecorator(keeper)
+			ctx := sdk.Context{}.
+				WithBlockHeader(tmproto.Header{Version: version.Consensus{App: tc.appVersion}})
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+type mockNamespaceKeeper map[string]namespacetypes.Registration
+
+func (m mockNamespaceKeeper) IsWriter(_ sdk.Context, namespace []byte, signer string) bool {
+	registration, ok := m[string(namespace)]
+	return !ok || registration.IsWriter(signer)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,10 +18,19 @@ const (
 	payForBlobGasDescriptor = "pay for blob"
 )
 
+// NamespaceKeeper is the subset of the namespace keeper used to record the
+// accounts that pay for blobs in a namespace.
+type NamespaceKeeper interface {
+	RecordBlobSigner(ctx sdk.Context, namespace []byte, signer string)
+}
+
 // Keeper handles all the state changes for the blob module.
 type Keeper struct {
 	cdc        codec.BinaryCodec
 	paramStore paramtypes.Subspace
+	// namespaceKeeper optionally records the signers of the PFBs so that
+	// only they can register the namespaces they pay for blobs in.
+	namespaceKeeper NamespaceKeeper
 }
 
 func NewKeeper(
@@ -37,6 +47,11 @@ func NewKeeper(
 	}
 }
 
+// SetNamespaceKeeper sets the namespaceKeeper
+func (k *Keeper) SetNamespaceKeeper(namespaceKeeper NamespaceKeeper) {
+	k.namespaceKeeper = namespaceKeeper
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -55,6 +70,16 @@ func (k Keeper) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*
 
 	ctx.GasMeter().ConsumeGas(gasToConsume, payForBlobGasDescriptor)
 
+	// The signers are recorded without consuming gas so that the gas used by
+	// a PFB, and thus its gas estimation, doesn't depend on whether its
+	// namespaces were used before.
+	if k.namespaceKeeper != nil && ctx.BlockHeader().Version.App >= v4.Version {
+		recordCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		for _, namespace := range msg.Namespaces {
+			k.namespaceKeeper.RecordBlobSigner(recordCtx, namespace, msg.Signer)
+		}
+	}
+
 	err := ctx.EventManager().EmitTypedEvent(
 		types.NewPayForBlobsEvent(msg.Signer, msg.BlobSizes, msg.Namespaces),
 	)
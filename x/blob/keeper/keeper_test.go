@@ -57,6 +57,35 @@ func TestPayForBlobs(t *testing.T) {
 	assert.Equal(t, blobSizes, event.BlobSizes)
 }
 
+func TestPayForBlobsRecordsBlobSigners(t *testing.T) {
+	signer := "celestia15drmhzw5kwgenvemy30rqqqgq52axf5wwrruf7"
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	msg := createMsgPayForBlob(t, signer, namespace, []byte("blob"))
+
+	k, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	namespaceKeeper := &mockNamespaceKeeper{}
+	k.SetNamespaceKeeper(namespaceKeeper)
+	_, err := k.PayForBlobs(ctx, msg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("%X/%s", namespace.Bytes(), signer)}, namespaceKeeper.recorded)
+
+	// nothing is recorded before the namespace module is introduced
+	k, _, ctx = CreateKeeper(t, 3)
+	namespaceKeeper = &mockNamespaceKeeper{}
+	k.SetNamespaceKeeper(namespaceKeeper)
+	_, err = k.PayForBlobs(ctx, msg)
+	require.NoError(t, err)
+	assert.Empty(t, namespaceKeeper.recorded)
+}
+
+type mockNamespaceKeeper struct {
+	recorded []string
+}
+
+func (m *mockNamespaceKeeper) RecordBlobSigner(_ sdk.Context, namespace []byte, signer string) {
+	m.recorded = append(m.recorded, fmt.Sprintf("%X/%s", namespace, signer))
+}
+
 func convertToEventPayForBlobs(message proto.Message) (*types.EventPayForBlobs, error) {
 	if event, ok := message.(*types.EventPayForBlobs); ok {
 		return event, nil
@@ -5,7 +5,6 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
-	"math/big"
 	"os"
 	"strconv"
 
@@ -14,6 +13,7 @@ import (
 
 	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	square "github.com/celestiaorg/go-square/v2"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -339,28 +339,18 @@ func VerifyDataRootInclusion(
 	nonce uint64,
 	height int64,
 	dataRoot []byte,
-	proof merkle.Proof,
+	dataRootProof merkle.Proof,
 ) (bool, error) {
-	tuple := wrapper.DataRootTuple{
-		Height:   big.NewInt(height),
-		DataRoot: *(*[32]byte)(dataRoot),
-	}
-
-	sideNodes := make([][32]byte, len(proof.Aunts))
-	for i, aunt := range proof.Aunts {
-		sideNodes[i] = *(*[32]byte)(aunt)
-	}
-	wrappedProof := wrapper.BinaryMerkleProof{
-		SideNodes: sideNodes,
-		Key:       big.NewInt(proof.Index),
-		NumLeaves: big.NewInt(proof.Total),
+	attestationProof, err := proof.NewABIAttestationProof(nonce, height, dataRoot, dataRootProof)
+	if err != nil {
+		return false, err
 	}
 
 	valid, err := bsWrapper.VerifyAttestation(
 		&bind.CallOpts{},
-		big.NewInt(int64(nonce)),
-		tuple,
-		wrappedProof,
+		attestationProof.TupleRootNonce,
+		attestationProof.Tuple,
+		attestationProof.Proof,
 	)
 	if err != nil {
 		return false, err
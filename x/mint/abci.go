@@ -10,11 +10,17 @@ import (
 )
 
 // BeginBlocker updates the inflation rate, annual provisions, and then mints
-// the block provision for the current block.
+// the block provision for the current block according to the params of the
+// keeper.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	beginBlocker(ctx, k, k.GetParams(ctx))
+}
+
+// beginBlocker is BeginBlocker with the inflation schedule of params.
+func beginBlocker(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
-	maybeUpdateMinter(ctx, k)
+	maybeUpdateMinter(ctx, k, params)
 	mintBlockProvision(ctx, k)
 	setPreviousBlockTime(ctx, k)
 }
@@ -22,11 +28,11 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 // maybeUpdateMinter updates the inflation rate and annual provisions if the
 // inflation rate has changed. The inflation rate is expected to change once per
 // year at the genesis time anniversary until the TargetInflationRate is
-// reached.
-func maybeUpdateMinter(ctx sdk.Context, k keeper.Keeper) {
+// reached, and in the first block after the params are changed.
+func maybeUpdateMinter(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	minter := k.GetMinter(ctx)
 	genesisTime := k.GetGenesisTime(ctx).GenesisTime
-	newInflationRate := minter.CalculateInflationRate(ctx, *genesisTime)
+	newInflationRate := minter.CalculateInflationRate(ctx, *genesisTime, params)
 
 	isNonZeroAnnualProvisions := !minter.AnnualProvisions.IsZero()
 	if newInflationRate.Equal(minter.InflationRate) && isNonZeroAnnualProvisions {
@@ -131,3 +131,130 @@ func TestAnnualProvisions(t *testing.T) {
 		})
 	})
 }
+
+// TestProvisionCurve simulates the inflation schedule over thirty years for a
+// grid of params covering their bounds and checks that the inflation rate
+// follows the schedule of the params and that the tokens minted each year
+// match the annual provisions.
+func TestProvisionCurve(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping provision curve simulation in short mode.")
+	}
+	const (
+		years         = 30
+		blocksPerYear = 12
+	)
+	blockInterval := oneYear / blocksPerYear
+
+	rates := func(max sdk.Dec) []sdk.Dec {
+		return []sdk.Dec{sdk.ZeroDec(), max.QuoInt64(2), max}
+	}
+	var grid []minttypes.Params
+	for _, initial := range append(rates(minttypes.MaxInitialInflationRate), minttypes.InitialInflationRateAsDec()) {
+		for _, disinflation := range append(rates(minttypes.MaxDisinflationRate), minttypes.DisinflationRateAsDec()) {
+			for _, target := range append(rates(minttypes.MaxTargetInflationRate), minttypes.TargetInflationRateAsDec()) {
+				params := minttypes.NewParams(initial, disinflation, target)
+				// skip the params that can't be set
+				if params.Validate() != nil {
+					continue
+				}
+				grid = append(grid, params)
+			}
+		}
+	}
+
+	for _, params := range grid {
+		name := fmt.Sprintf("initial %v disinflation %v target %v", params.InitialInflationRate, params.DisinflationRate, params.TargetInflationRate)
+		t.Run(name, func(t *testing.T) {
+			a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+			ctx := sdk.NewContext(a.CommitMultiStore(), types.Header{}, false, tmlog.NewNopLogger())
+			a.MintKeeper.SetParams(ctx, params)
+			genesisTime := *a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+
+			previousRate := sdk.OneDec()
+			height := int64(1)
+			for year := int64(0); year < years; year++ {
+				yearStart := genesisTime.Add(time.Duration(year) * oneYear)
+				ctx = ctx.WithBlockHeight(height).WithBlockTime(yearStart)
+				mint.BeginBlocker(ctx, a.MintKeeper)
+				height++
+
+				minter := a.MintKeeper.GetMinter(ctx)
+				want := params.InitialInflationRate.Mul(sdk.OneDec().Sub(params.DisinflationRate).Power(uint64(year)))
+				if want.LT(params.TargetInflationRate) {
+					want = params.TargetInflationRate
+				}
+				require.Equal(t, want, minter.InflationRate, "year %d", year)
+				require.True(t, minter.InflationRate.LTE(previousRate), "inflation rate increased in year %d", year)
+				require.True(t, minter.InflationRate.GTE(params.TargetInflationRate), "inflation rate below target in year %d", year)
+				previousRate = minter.InflationRate
+
+				supplyAtYearStart := a.MintKeeper.StakingTokenSupply(ctx)
+				for block := 1; block <= blocksPerYear; block++ {
+					ctx = ctx.WithBlockHeight(height).WithBlockTime(yearStart.Add(time.Duration(block) * blockInterval))
+					mint.BeginBlocker(ctx, a.MintKeeper)
+					height++
+				}
+				// The last block of the year is the first block of the next
+				// year so it mints the provision of the last interval with the
+				// inflation rate of the next year.
+				minted := a.MintKeeper.StakingTokenSupply(ctx).Sub(supplyAtYearStart)
+				require.False(t, minted.IsNegative(), "supply decreased in year %d", year)
+				assert.InDelta(t, minter.AnnualProvisions.MustFloat64(), sdk.NewDecFromInt(minted).MustFloat64(), minter.AnnualProvisions.MustFloat64()/blocksPerYear+blocksPerYear, "year %d", year)
+			}
+		})
+	}
+}
+
+func TestParamsChangeTakesEffectNextBlock(t *testing.T) {
+	a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(a.CommitMultiStore(), types.Header{}, false, tmlog.NewNopLogger())
+	genesisTime := *a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(genesisTime.Add(oneYear))
+	mint.BeginBlocker(ctx, a.MintKeeper)
+	require.Equal(t, sdk.MustNewDecFromStr("0.072"), a.MintKeeper.GetMinter(ctx).InflationRate)
+
+	params := minttypes.NewParams(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.01"))
+	a.MintKeeper.SetParams(ctx, params)
+	supply := a.MintKeeper.StakingTokenSupply(ctx)
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(genesisTime.Add(oneYear).Add(time.Minute))
+	mint.BeginBlocker(ctx, a.MintKeeper)
+
+	// the inflation rate of year one is 0.1 * (1 - 0.5) ^ 1
+	minter := a.MintKeeper.GetMinter(ctx)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.05"), minter.InflationRate)
+	assert.Equal(t, minter.InflationRate.MulInt(supply), minter.AnnualProvisions)
+}
+
+// TestSupplyProjectionMatchesBeginBlocker checks that the supply projection
+// matches the supply minted by the BeginBlocker with daily blocks.
+func TestSupplyProjectionMatchesBeginBlocker(t *testing.T) {
+	const years = 3
+	a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(a.CommitMultiStore(), types.Header{}, false, tmlog.NewNopLogger())
+	genesisTime := *a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(genesisTime)
+	mint.BeginBlocker(ctx, a.MintKeeper)
+
+	res, err := a.MintKeeper.SupplyProjection(ctx, &minttypes.QuerySupplyProjectionRequest{Years: years})
+	require.NoError(t, err)
+	require.Len(t, res.Years, years)
+
+	height := int64(2)
+	for _, projection := range res.Years {
+		for blockTime := ctx.BlockTime().Add(24 * time.Hour); blockTime.Before(projection.Time); blockTime = blockTime.Add(24 * time.Hour) {
+			ctx = ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+			mint.BeginBlocker(ctx, a.MintKeeper)
+			height++
+		}
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(projection.Time)
+		mint.BeginBlocker(ctx, a.MintKeeper)
+		height++
+
+		minter := a.MintKeeper.GetMinter(ctx)
+		assert.Equal(t, projection.InflationRate, minter.InflationRate, "year %d", projection.Year)
+		assert.InEpsilon(t, sdk.NewDecFromInt(projection.TotalSupply).MustFloat64(), sdk.NewDecFromInt(a.MintKeeper.StakingTokenSupply(ctx)).MustFloat64(), 1e-4, "year %d", projection.Year)
+		assert.InEpsilon(t, projection.AnnualProvisions.MustFloat64(), minter.AnnualProvisions.MustFloat64(), 1e-4, "year %d", projection.Year)
+	}
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryInflationRate(),
 		GetCmdQueryAnnualProvisions(),
 		GetCmdQueryGenesisTime(),
+		GetCmdQueryParams(),
+		GetCmdQuerySupplyProjection(),
 	)
 
 	return mintQueryCmd
@@ -114,3 +118,84 @@ func GetCmdQueryGenesisTime() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryParams implements a command to return the params of the inflation
+// schedule.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the params of the inflation schedule",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryParamsRequest{}
+			res, err := queryClient.Params(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// FlagTime is the flag of the time to project the supply at.
+const FlagTime = "time"
+
+// GetCmdQuerySupplyProjection implements a command to return the projected
+// total supply, annual provisions and inflation rate for each of the next years
+// and at a future time.
+func GetCmdQuerySupplyProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-projection [years]",
+		Short: "Query the projected supply, annual provisions and inflation rate for each of the next years",
+		Long: "Query the projected total supply, annual provisions and inflation rate at each of the next genesis anniversaries " +
+			"and, with the --time flag, at a future time. The projection assumes that the params don't change and that no tokens are burned.",
+		Example: fmt.Sprintf("$ celestia-appd query mint supply-projection 10 --%s 2030-01-01T00:00:00Z", FlagTime),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QuerySupplyProjectionRequest{}
+			if len(args) == 1 {
+				years, err := strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid number of years %q: %w", args[0], err)
+				}
+				request.Years = uint32(years)
+			}
+			if timeStr, _ := cmd.Flags().GetString(FlagTime); timeStr != "" {
+				t, err := time.Parse(time.RFC3339, timeStr)
+				if err != nil {
+					return fmt.Errorf("invalid time %q: %w", timeStr, err)
+				}
+				request.Time = &t
+			}
+
+			res, err := queryClient.SupplyProjection(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTime, "", "Future time to project the supply at, in RFC3339 format")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
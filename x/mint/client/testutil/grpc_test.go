@@ -45,6 +45,15 @@ func (s *IntegrationTestSuite) TestQueryGRPC() {
 				AnnualProvisions: expectedAnnualProvision,
 			},
 		},
+		{
+			"gRPC request params",
+			fmt.Sprintf("%s/celestia/mint/v1/params", baseURL),
+			map[string]string{},
+			&mint.QueryParamsResponse{},
+			&mint.QueryParamsResponse{
+				Params: mint.DefaultParams(),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -25,5 +25,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *types
 // ExportGenesis returns a x/mint GenesisState for the given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	bondDenom := k.GetMinter(ctx).BondDenom
-	return types.NewGenesisState(bondDenom)
+	genesis := types.NewGenesisState(bondDenom)
+	params := k.GetParams(ctx)
+	genesis.Params = &params
+	return genesis
 }
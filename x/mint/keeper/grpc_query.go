@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -32,3 +35,44 @@ func (k Keeper) GenesisTime(c context.Context, _ *types.QueryGenesisTimeRequest)
 
 	return &types.QueryGenesisTimeResponse{GenesisTime: genesisTime}, nil
 }
+
+// Params returns the params of the inflation schedule.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// SupplyProjection returns the projected total supply, annual provisions and
+// inflation rate at the next genesis anniversaries and at the requested time
+// according to the current minter, total supply and params.
+func (k Keeper) SupplyProjection(c context.Context, req *types.QuerySupplyProjectionRequest) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Years == 0 && req.Time == nil {
+		return nil, status.Error(codes.InvalidArgument, "years or time must be set")
+	}
+	if req.Years > types.MaxSupplyProjectionYears {
+		return nil, status.Errorf(codes.InvalidArgument, "years %d cannot be greater than %d", req.Years, types.MaxSupplyProjectionYears)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Time != nil {
+		if req.Time.Before(ctx.BlockTime()) {
+			return nil, status.Errorf(codes.InvalidArgument, "time %v cannot be before the current block time %v", req.Time, ctx.BlockTime())
+		}
+		maxTime := ctx.BlockTime().Add(time.Duration(types.MaxSupplyProjectionYears * types.NanosecondsPerYear))
+		if req.Time.After(maxTime) {
+			return nil, status.Errorf(codes.InvalidArgument, "time %v cannot be more than %d years after the current block time", req.Time, types.MaxSupplyProjectionYears)
+		}
+	}
+
+	minter := k.GetMinter(ctx)
+	genesisTime := k.GetGenesisTime(ctx).GenesisTime
+	years, atTime, err := minter.ProjectSupply(ctx, *genesisTime, k.GetParams(ctx), k.StakingTokenSupply(ctx), req.Years, req.Time)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyProjectionResponse{Years: years, AtTime: atTime}, nil
+}
@@ -3,9 +3,12 @@ package keeper_test //nolint:all
 import (
 	gocontext "context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
@@ -50,6 +53,46 @@ func (suite *MintTestSuite) TestGRPC() {
 	genesisTime, err := queryClient.GenesisTime(gocontext.Background(), &types.QueryGenesisTimeRequest{})
 	suite.Require().NoError(err)
 	suite.Require().Equal(genesisTime.GenesisTime, app.MintKeeper.GetGenesisTime(ctx).GenesisTime)
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), params.Params)
+
+	newParams := types.NewParams(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	app.MintKeeper.SetParams(ctx, newParams)
+	params, err = queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(newParams, params.Params)
+
+	projection, err := queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Years: 2})
+	suite.Require().NoError(err)
+	suite.Require().Len(projection.Years, 2)
+	suite.Require().Nil(projection.AtTime)
+}
+
+func (suite *MintTestSuite) TestSupplyProjectionInvalidRequest() {
+	app := suite.app
+	blockTime := *app.MintKeeper.GetGenesisTime(suite.ctx).GenesisTime
+	ctx := sdk.WrapSDKContext(suite.ctx.WithBlockTime(blockTime))
+	before := blockTime.Add(-time.Second)
+	tooLate := blockTime.Add(time.Duration((types.MaxSupplyProjectionYears + 1) * types.NanosecondsPerYear))
+
+	testCases := []struct {
+		name string
+		req  *types.QuerySupplyProjectionRequest
+	}{
+		{"no years nor time", &types.QuerySupplyProjectionRequest{}},
+		{"too many years", &types.QuerySupplyProjectionRequest{Years: types.MaxSupplyProjectionYears + 1}},
+		{"time before the current block", &types.QuerySupplyProjectionRequest{Time: &before}},
+		{"time too far in the future", &types.QuerySupplyProjectionRequest{Time: &tooLate}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := app.MintKeeper.SupplyProjection(ctx, tc.req)
+			suite.Require().Error(err)
+			suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+		})
+	}
 }
 
 func TestMintTestSuite(t *testing.T) {
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the mint module from consensus version 1 to 2. It sets
+// the params of the inflation schedule to the default params, which are the
+// values hardcoded in consensus version 1, so that the inflation rate doesn't
+// change with the migration.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
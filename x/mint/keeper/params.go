@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the params of the inflation schedule. The default params
+// are returned if the params haven't been set, which is the case before app
+// version 4.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramStore.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the params of the inflation schedule.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
@@ -81,15 +81,31 @@ type AppModule struct {
 
 	keeper     keeper.Keeper
 	authKeeper types.AccountKeeper
+	// consensusVersion is 1 for the module with the hardcoded inflation
+	// schedule and 2 for the module with the inflation schedule of the params.
+	consensusVersion uint64
 }
 
-// NewAppModule creates a new AppModule object. If the InflationCalculationFn
-// argument is nil, then the SDK's default inflation function will be used.
+// NewAppModule creates a new AppModule object whose inflation schedule is set
+// by the params of the keeper. It is used from app version 4.
 func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak types.AccountKeeper) AppModule {
 	return AppModule{
-		AppModuleBasic: AppModuleBasic{cdc: cdc},
-		keeper:         keeper,
-		authKeeper:     ak,
+		AppModuleBasic:   AppModuleBasic{cdc: cdc},
+		keeper:           keeper,
+		authKeeper:       ak,
+		consensusVersion: 2,
+	}
+}
+
+// NewAppModuleV1 creates a new AppModule object whose inflation schedule is
+// the one of the default params, whatever the params of the keeper are. It is
+// used by app versions 1 to 3.
+func NewAppModuleV1(cdc codec.Codec, keeper keeper.Keeper, ak types.AccountKeeper) AppModule {
+	return AppModule{
+		AppModuleBasic:   AppModuleBasic{cdc: cdc},
+		keeper:           keeper,
+		authKeeper:       ak,
+		consensusVersion: 1,
 	}
 }
 
@@ -116,9 +132,19 @@ func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 }
 
 // RegisterServices registers a gRPC query service to respond to the
-// module-specific gRPC queries.
+// module-specific gRPC queries and the migrations of the module. The query
+// service is only registered by the module of consensus version 1 because it
+// can't be registered twice and the queries are the same for both versions.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	if am.consensusVersion == 1 {
+		types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+		return
+	}
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the mint module. It returns
@@ -128,6 +154,13 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
 	am.keeper.InitGenesis(ctx, am.authKeeper, &genesisState)
+	if am.consensusVersion >= 2 {
+		params := types.DefaultParams()
+		if genesisState.Params != nil {
+			params = *genesisState.Params
+		}
+		am.keeper.SetParams(ctx, params)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -139,10 +172,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (am AppModule) ConsensusVersion() uint64 { return am.consensusVersion }
 
 // BeginBlock returns the begin blocker for the mint module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	if am.consensusVersion == 1 {
+		beginBlocker(ctx, am.keeper, types.DefaultParams())
+		return
+	}
 	BeginBlocker(ctx, am.keeper)
 }
 
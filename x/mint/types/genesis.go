@@ -11,8 +11,10 @@ func NewGenesisState(bondDenom string) *GenesisState {
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
 	return &GenesisState{
 		BondDenom: DefaultBondDenom,
+		Params:    &params,
 	}
 }
 
@@ -22,5 +24,8 @@ func ValidateGenesis(data GenesisState) error {
 	if data.BondDenom == "" {
 		return errors.New("bond denom cannot be empty")
 	}
+	if data.Params != nil {
+		return data.Params.Validate()
+	}
 	return nil
 }
@@ -7,7 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/gogoproto/gogoproto"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
@@ -137,39 +137,91 @@ func (m *GenesisTime) GetGenesisTime() *time.Time {
 	return nil
 }
 
+// Params defines the parameters of the inflation schedule. The inflation rate
+// of a year is InitialInflationRate * (1 - DisinflationRate)^year with a
+// minimum of TargetInflationRate.
+type Params struct {
+	// InitialInflationRate is the inflation rate of the first year.
+	InitialInflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=initial_inflation_rate,json=initialInflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_inflation_rate"`
+	// DisinflationRate is the rate at which the inflation rate decreases each
+	// year.
+	DisinflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=disinflation_rate,json=disinflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"disinflation_rate"`
+	// TargetInflationRate is the inflation rate that the network aims to
+	// stabilize at. It acts as a minimum of the inflation rate.
+	TargetInflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_inflation_rate,json=targetInflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_inflation_rate"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962d7cf1c9c59571, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "celestia.mint.v1.Minter")
 	proto.RegisterType((*GenesisTime)(nil), "celestia.mint.v1.GenesisTime")
+	proto.RegisterType((*Params)(nil), "celestia.mint.v1.Params")
 }
 
 func init() { proto.RegisterFile("celestia/mint/v1/mint.proto", fileDescriptor_962d7cf1c9c59571) }
 
 var fileDescriptor_962d7cf1c9c59571 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xc1, 0xca, 0xd3, 0x40,
-	0x10, 0xce, 0x96, 0x5a, 0xe8, 0x56, 0xa5, 0x8d, 0x1e, 0x62, 0xc5, 0xa4, 0xf4, 0x20, 0xbd, 0x34,
-	0xb1, 0x7a, 0xf5, 0x14, 0x0b, 0x82, 0x20, 0x94, 0xe0, 0xc9, 0x4b, 0xd8, 0xa4, 0xdb, 0x75, 0x69,
-	0xb2, 0x13, 0xb2, 0x9b, 0xa0, 0x6f, 0xd1, 0x87, 0xf1, 0x21, 0xea, 0xad, 0x78, 0x12, 0x0f, 0x55,
-	0xda, 0x17, 0x91, 0xcd, 0x26, 0xd5, 0xa3, 0x87, 0xff, 0xb4, 0x33, 0xf3, 0xcd, 0x7c, 0xdf, 0xec,
-	0xc7, 0xe0, 0xa7, 0x29, 0xcd, 0xa8, 0x54, 0x9c, 0x04, 0x39, 0x17, 0x2a, 0xa8, 0x57, 0xcd, 0xeb,
-	0x17, 0x25, 0x28, 0xb0, 0xc7, 0x1d, 0xe8, 0x37, 0xc5, 0x7a, 0x35, 0x7d, 0xcc, 0x80, 0x41, 0x03,
-	0x06, 0x3a, 0x32, 0x7d, 0xd3, 0x27, 0x29, 0xc8, 0x1c, 0x64, 0x6c, 0x00, 0x93, 0xb4, 0x90, 0xc7,
-	0x00, 0x58, 0x46, 0x83, 0x26, 0x4b, 0xaa, 0x5d, 0xa0, 0x78, 0x4e, 0xa5, 0x22, 0x79, 0x61, 0x1a,
-	0xe6, 0xdf, 0x7a, 0x78, 0xf0, 0x9e, 0x0b, 0x45, 0x4b, 0x3b, 0xc5, 0x0f, 0xb9, 0xd8, 0x65, 0x44,
-	0x71, 0x10, 0x71, 0x49, 0x14, 0x75, 0xd0, 0x0c, 0x2d, 0x86, 0xe1, 0xeb, 0xe3, 0xd9, 0xb3, 0x7e,
-	0x9e, 0xbd, 0xe7, 0x8c, 0xab, 0x4f, 0x55, 0xe2, 0xa7, 0x90, 0xb7, 0x22, 0xed, 0xb3, 0x94, 0xdb,
-	0x7d, 0xa0, 0xbe, 0x14, 0x54, 0xfa, 0x6b, 0x9a, 0x7e, 0xff, 0xba, 0xc4, 0xed, 0x0e, 0x6b, 0x9a,
-	0x46, 0x0f, 0x6e, 0x9c, 0x11, 0x51, 0xd4, 0xe6, 0x78, 0x42, 0x84, 0xa8, 0x48, 0xa6, 0xb7, 0xad,
-	0xb9, 0xe4, 0x20, 0xa4, 0xd3, 0xbb, 0x03, 0x9d, 0xb1, 0xa1, 0xdd, 0xdc, 0x58, 0xed, 0x0d, 0x7e,
-	0x54, 0x94, 0xb4, 0xe6, 0x50, 0xc9, 0x38, 0xc9, 0x20, 0xdd, 0xc7, 0xfa, 0xf3, 0x4e, 0x7f, 0x86,
-	0x16, 0xa3, 0x97, 0x53, 0xdf, 0x38, 0xe3, 0x77, 0xce, 0xf8, 0x1f, 0x3a, 0x67, 0xc2, 0xfe, 0xe1,
-	0x97, 0x87, 0xa2, 0x49, 0x37, 0x1c, 0xea, 0x59, 0x8d, 0xda, 0xcf, 0x30, 0x4e, 0x40, 0x6c, 0xe3,
-	0x2d, 0x15, 0x90, 0x3b, 0xf7, 0xf4, 0xd6, 0xd1, 0x50, 0x57, 0xd6, 0xba, 0x30, 0x8f, 0xf0, 0xe8,
-	0x2d, 0x15, 0x54, 0x72, 0xd9, 0x74, 0xbf, 0xc1, 0xf7, 0x99, 0x49, 0x8d, 0x30, 0xfa, 0x4f, 0xe1,
-	0x11, 0xfb, 0x4b, 0x12, 0xbe, 0x3b, 0x5e, 0x5c, 0x74, 0xba, 0xb8, 0xe8, 0xf7, 0xc5, 0x45, 0x87,
-	0xab, 0x6b, 0x9d, 0xae, 0xae, 0xf5, 0xe3, 0xea, 0x5a, 0x1f, 0x5f, 0xfc, 0x6b, 0x53, 0x7b, 0x28,
-	0x50, 0xb2, 0x5b, 0xbc, 0x24, 0x45, 0x11, 0x7c, 0x36, 0x77, 0xd5, 0x98, 0x96, 0x0c, 0x1a, 0xc9,
-	0x57, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x71, 0xfd, 0xfc, 0xbb, 0x75, 0x02, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0x32, 0x2a, 0xcd, 0x05, 0xb4, 0x65, 0x03, 0x95, 0x22, 0xd2, 0xa9, 0x07, 0xb4,
+	0x4b, 0x13, 0x06, 0x57, 0x4e, 0xa5, 0x12, 0x02, 0x09, 0xa9, 0x8a, 0x38, 0x71, 0x89, 0x9c, 0xd4,
+	0x33, 0x8f, 0x96, 0xf8, 0x89, 0x6c, 0xb7, 0x82, 0x6f, 0xb1, 0x0f, 0xc3, 0x87, 0x18, 0xb7, 0x89,
+	0x13, 0x70, 0x18, 0xa8, 0xfd, 0x22, 0xc8, 0x76, 0x52, 0x4a, 0x4f, 0x1c, 0xca, 0xc9, 0x7e, 0x5e,
+	0xfc, 0xff, 0x3d, 0x2f, 0x32, 0x7d, 0x94, 0xf3, 0x82, 0x6b, 0x03, 0x2c, 0x2e, 0x41, 0x9a, 0x78,
+	0x71, 0xe6, 0xce, 0xa8, 0x52, 0x68, 0x30, 0x38, 0x68, 0x82, 0x91, 0x73, 0x2e, 0xce, 0xfa, 0xc7,
+	0x02, 0x05, 0xba, 0x60, 0x6c, 0x6f, 0x3e, 0xaf, 0xff, 0x30, 0x47, 0x5d, 0xa2, 0x4e, 0x7d, 0xc0,
+	0x1b, 0x75, 0x68, 0x20, 0x10, 0x45, 0xc1, 0x63, 0x67, 0x65, 0xf3, 0xf3, 0xd8, 0x40, 0xc9, 0xb5,
+	0x61, 0x65, 0xe5, 0x13, 0x86, 0x5f, 0xda, 0xb4, 0xf3, 0x16, 0xa4, 0xe1, 0x2a, 0xc8, 0xe9, 0x3d,
+	0x90, 0xe7, 0x05, 0x33, 0x80, 0x32, 0x55, 0xcc, 0xf0, 0x1e, 0x39, 0x21, 0xa7, 0xfb, 0xe3, 0x17,
+	0x57, 0x37, 0x83, 0xd6, 0x8f, 0x9b, 0xc1, 0x13, 0x01, 0xe6, 0xc3, 0x3c, 0x8b, 0x72, 0x2c, 0x6b,
+	0x48, 0x7d, 0x8c, 0xf4, 0xec, 0x22, 0x36, 0x9f, 0x2a, 0xae, 0xa3, 0x09, 0xcf, 0xbf, 0x7e, 0x1e,
+	0xd1, 0xba, 0x86, 0x09, 0xcf, 0x93, 0xbb, 0x6b, 0xcd, 0x84, 0x19, 0x1e, 0x00, 0x3d, 0x64, 0x52,
+	0xce, 0x59, 0x61, 0xab, 0x5d, 0x80, 0x06, 0x94, 0xba, 0xd7, 0xde, 0x01, 0xe7, 0xc0, 0xcb, 0x4e,
+	0xd7, 0xaa, 0xc1, 0x94, 0x1e, 0x55, 0x8a, 0x2f, 0x00, 0xe7, 0x3a, 0xcd, 0x0a, 0xcc, 0x2f, 0x52,
+	0xdb, 0x7c, 0x6f, 0xef, 0x84, 0x9c, 0x76, 0x9f, 0xf5, 0x23, 0x3f, 0x99, 0xa8, 0x99, 0x4c, 0xf4,
+	0xae, 0x99, 0xcc, 0x78, 0xef, 0xf2, 0xe7, 0x80, 0x24, 0x87, 0xcd, 0xe3, 0xb1, 0x7d, 0x6b, 0xa3,
+	0xc1, 0x63, 0x4a, 0x33, 0x94, 0xb3, 0x74, 0xc6, 0x25, 0x96, 0xbd, 0xdb, 0xb6, 0xea, 0x64, 0xdf,
+	0x7a, 0x26, 0xd6, 0x31, 0x4c, 0x68, 0xf7, 0x15, 0x97, 0x5c, 0x83, 0x76, 0xd9, 0x2f, 0xe9, 0x1d,
+	0xe1, 0x4d, 0x0f, 0x26, 0xff, 0x08, 0xee, 0x8a, 0x3f, 0x22, 0xc3, 0xef, 0x6d, 0xda, 0x99, 0x32,
+	0xc5, 0x4a, 0x1d, 0x28, 0xfa, 0x00, 0x24, 0x18, 0x60, 0x45, 0xfa, 0x1f, 0xf6, 0x74, 0x5c, 0x6b,
+	0xbf, 0xde, 0x5e, 0xd7, 0x0c, 0xf4, 0x16, 0x6e, 0x27, 0xeb, 0xda, 0x94, 0x75, 0xa8, 0x8a, 0xde,
+	0x37, 0x4c, 0x09, 0x6e, 0xb6, 0xbb, 0xbb, 0xb5, 0x03, 0xdc, 0x91, 0x97, 0xfe, 0xab, 0xb9, 0xf1,
+	0x9b, 0xab, 0x65, 0x48, 0xae, 0x97, 0x21, 0xf9, 0xb5, 0x0c, 0xc9, 0xe5, 0x2a, 0x6c, 0x5d, 0xaf,
+	0xc2, 0xd6, 0xb7, 0x55, 0xd8, 0x7a, 0xff, 0x74, 0x13, 0x52, 0x7f, 0x42, 0x54, 0x62, 0x7d, 0x1f,
+	0xb1, 0xaa, 0x8a, 0x3f, 0xfa, 0x3f, 0xeb, 0x90, 0x59, 0xc7, 0xad, 0xf3, 0xf9, 0xef, 0x01, 0x00,
+	0xdd, 0xa1, 0xf2, 0xda, 0xd1, 0x03, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -265,6 +317,59 @@ func (m *GenesisTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetInflationRate.Size()
+		i -= size
+		if _, err := m.TargetInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DisinflationRate.Size()
+		i -= size
+		if _, err := m.DisinflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialInflationRate.Size()
+		i -= size
+		if _, err := m.InitialInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -310,6 +415,21 @@ func (m *GenesisTime) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialInflationRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DisinflationRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.TargetInflationRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
 func sovMint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -588,6 +708,158 @@ func (m *GenesisTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisinflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisinflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
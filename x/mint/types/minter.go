@@ -40,13 +40,14 @@ func (m Minter) Validate() error {
 
 // CalculateInflationRate returns the inflation rate for the current year depending on
 // the current block height in context. The inflation rate is expected to
-// decrease every year according to the schedule specified in the README.
-func (m Minter) CalculateInflationRate(ctx sdk.Context, genesis time.Time) sdk.Dec {
+// decrease every year according to the schedule of params specified in the
+// README.
+func (m Minter) CalculateInflationRate(ctx sdk.Context, genesis time.Time, params Params) sdk.Dec {
 	years := yearsSinceGenesis(genesis, ctx.BlockTime())
-	inflationRate := InitialInflationRateAsDec().Mul(sdk.OneDec().Sub(DisinflationRateAsDec()).Power(uint64(years)))
+	inflationRate := params.InitialInflationRate.Mul(sdk.OneDec().Sub(params.DisinflationRate).Power(uint64(years)))
 
-	if inflationRate.LT(TargetInflationRateAsDec()) {
-		return TargetInflationRateAsDec()
+	if inflationRate.LT(params.TargetInflationRate) {
+		return params.TargetInflationRate
 	}
 	return inflationRate
 }
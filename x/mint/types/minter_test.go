@@ -69,7 +69,7 @@ func TestCalculateInflationRate(t *testing.T) {
 		years := time.Duration(tc.year * NanosecondsPerYear * int64(time.Nanosecond))
 		blockTime := genesisTime.Add(years)
 		ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil).WithBlockTime(blockTime)
-		inflationRate := minter.CalculateInflationRate(ctx, genesisTime)
+		inflationRate := minter.CalculateInflationRate(ctx, genesisTime, DefaultParams())
 		got, err := inflationRate.Float64()
 		assert.NoError(t, err)
 		assert.Equal(t, tc.want, got, "want %v got %v year %v blockTime %v", tc.want, got, tc.year, blockTime)
@@ -189,7 +189,7 @@ func BenchmarkCalculateInflationRate(b *testing.B) {
 
 	for n := 0; n < b.N; n++ {
 		ctx := sdk.NewContext(nil, tmproto.Header{Height: int64(n)}, false, nil)
-		minter.CalculateInflationRate(ctx, genesisTime)
+		minter.CalculateInflationRate(ctx, genesisTime, DefaultParams())
 	}
 }
 
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyInitialInflationRate = []byte("InitialInflationRate")
+	KeyDisinflationRate     = []byte("DisinflationRate")
+	KeyTargetInflationRate  = []byte("TargetInflationRate")
+)
+
+var (
+	// MaxInitialInflationRate is the maximum value of the InitialInflationRate
+	// param.
+	MaxInitialInflationRate = sdk.NewDecWithPrec(20, 2)
+	// MaxDisinflationRate is the maximum value of the DisinflationRate param.
+	MaxDisinflationRate = sdk.NewDecWithPrec(50, 2)
+	// MaxTargetInflationRate is the maximum value of the TargetInflationRate
+	// param.
+	MaxTargetInflationRate = sdk.NewDecWithPrec(20, 2)
+)
+
+// ParamKeyTable returns the param key table for the mint module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(initialInflationRate, disinflationRate, targetInflationRate sdk.Dec) Params {
+	return Params{
+		InitialInflationRate: initialInflationRate,
+		DisinflationRate:     disinflationRate,
+		TargetInflationRate:  targetInflationRate,
+	}
+}
+
+// DefaultParams returns the params of the inflation schedule used before app
+// version 4.
+func DefaultParams() Params {
+	return NewParams(InitialInflationRateAsDec(), DisinflationRateAsDec(), TargetInflationRateAsDec())
+}
+
+// ParamSetPairs gets the list of param key-value pairs
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyInitialInflationRate, &p.InitialInflationRate, validateInitialInflationRate),
+		paramtypes.NewParamSetPair(KeyDisinflationRate, &p.DisinflationRate, validateDisinflationRate),
+		paramtypes.NewParamSetPair(KeyTargetInflationRate, &p.TargetInflationRate, validateTargetInflationRate),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateInitialInflationRate(p.InitialInflationRate); err != nil {
+		return err
+	}
+	if err := validateDisinflationRate(p.DisinflationRate); err != nil {
+		return err
+	}
+	if err := validateTargetInflationRate(p.TargetInflationRate); err != nil {
+		return err
+	}
+	if p.TargetInflationRate.GT(p.InitialInflationRate) {
+		return fmt.Errorf("target inflation rate %v cannot be greater than initial inflation rate %v", p.TargetInflationRate, p.InitialInflationRate)
+	}
+	return nil
+}
+
+// validateInitialInflationRate validates the InitialInflationRate param
+func validateInitialInflationRate(v interface{}) error {
+	return validateRate("initial inflation rate", v, MaxInitialInflationRate)
+}
+
+// validateDisinflationRate validates the DisinflationRate param
+func validateDisinflationRate(v interface{}) error {
+	return validateRate("disinflation rate", v, MaxDisinflationRate)
+}
+
+// validateTargetInflationRate validates the TargetInflationRate param
+func validateTargetInflationRate(v interface{}) error {
+	return validateRate("target inflation rate", v, MaxTargetInflationRate)
+}
+
+// validateRate returns an error if v isn't a sdk.Dec in [0, max].
+func validateRate(name string, v interface{}, max sdk.Dec) error {
+	rate, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if rate.IsNil() {
+		return fmt.Errorf("%s cannot be nil", name)
+	}
+	if rate.IsNegative() {
+		return fmt.Errorf("%s %v cannot be negative", name, rate)
+	}
+	if rate.GT(max) {
+		return fmt.Errorf("%s %v cannot be greater than %v", name, rate, max)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsValidate(t *testing.T) {
+	type testCase struct {
+		name    string
+		params  Params
+		wantErr bool
+	}
+	testCases := []testCase{
+		{
+			name:   "default params",
+			params: DefaultParams(),
+		},
+		{
+			name:   "zero params",
+			params: NewParams(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		},
+		{
+			name:   "max params",
+			params: NewParams(MaxInitialInflationRate, MaxDisinflationRate, MaxTargetInflationRate),
+		},
+		{
+			name:   "target inflation rate equal to initial inflation rate",
+			params: NewParams(sdk.NewDecWithPrec(2, 2), DisinflationRateAsDec(), sdk.NewDecWithPrec(2, 2)),
+		},
+		{
+			name:    "target inflation rate greater than initial inflation rate",
+			params:  NewParams(sdk.NewDecWithPrec(1, 2), DisinflationRateAsDec(), sdk.NewDecWithPrec(2, 2)),
+			wantErr: true,
+		},
+		{
+			name:    "negative initial inflation rate",
+			params:  NewParams(sdk.NewDecWithPrec(-1, 2), DisinflationRateAsDec(), TargetInflationRateAsDec()),
+			wantErr: true,
+		},
+		{
+			name:    "initial inflation rate greater than max",
+			params:  NewParams(MaxInitialInflationRate.Add(sdk.SmallestDec()), DisinflationRateAsDec(), TargetInflationRateAsDec()),
+			wantErr: true,
+		},
+		{
+			name:    "negative disinflation rate",
+			params:  NewParams(InitialInflationRateAsDec(), sdk.NewDecWithPrec(-1, 2), TargetInflationRateAsDec()),
+			wantErr: true,
+		},
+		{
+			name:    "disinflation rate greater than max",
+			params:  NewParams(InitialInflationRateAsDec(), MaxDisinflationRate.Add(sdk.SmallestDec()), TargetInflationRateAsDec()),
+			wantErr: true,
+		},
+		{
+			name:    "negative target inflation rate",
+			params:  NewParams(InitialInflationRateAsDec(), DisinflationRateAsDec(), sdk.NewDecWithPrec(-1, 2)),
+			wantErr: true,
+		},
+		{
+			name:    "target inflation rate greater than max",
+			params:  NewParams(InitialInflationRateAsDec(), DisinflationRateAsDec(), MaxTargetInflationRate.Add(sdk.SmallestDec())),
+			wantErr: true,
+		},
+		{
+			name:    "nil rate",
+			params:  Params{InitialInflationRate: InitialInflationRateAsDec(), DisinflationRate: DisinflationRateAsDec()},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
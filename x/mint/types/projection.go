@@ -0,0 +1,106 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSupplyProjectionYears is the maximum number of years after the current
+// block that the supply can be projected at.
+const MaxSupplyProjectionYears = 100
+
+// ProjectSupply projects the total supply, the inflation rate and the annual
+// provisions at the genesis anniversaries of the next years after the block
+// time of ctx and, if at isn't nil, at the time at. The projection starts from
+// the minter and the total supply at the block time of ctx and assumes that
+// blocks are produced continuously, that params don't change and that no
+// tokens are burned.
+func (m Minter) ProjectSupply(ctx sdk.Context, genesis time.Time, params Params, supply sdk.Int, years uint32, at *time.Time) ([]SupplyProjection, *SupplyProjection, error) {
+	start := supplyProjector{
+		ctx:     ctx,
+		genesis: genesis,
+		params:  params,
+		minter:  m,
+		supply:  supply,
+		time:    ctx.BlockTime(),
+	}
+	// the params may have changed since the minter was last updated.
+	start.updateMinter()
+	currentYear := yearsSinceGenesis(genesis, start.time)
+
+	p := start
+	projections := make([]SupplyProjection, 0, years)
+	for year := currentYear + 1; year <= currentYear+int64(years); year++ {
+		if err := p.advance(anniversary(genesis, year)); err != nil {
+			return nil, nil, err
+		}
+		projections = append(projections, p.projection())
+	}
+
+	if at == nil {
+		return projections, nil, nil
+	}
+	p = start
+	for year := currentYear + 1; !anniversary(genesis, year).After(*at); year++ {
+		if err := p.advance(anniversary(genesis, year)); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := p.advance(*at); err != nil {
+		return nil, nil, err
+	}
+	atProjection := p.projection()
+	return projections, &atProjection, nil
+}
+
+// anniversary returns the time at which year years have passed since genesis.
+func anniversary(genesis time.Time, year int64) time.Time {
+	return genesis.Add(time.Duration(year * NanosecondsPerYear))
+}
+
+// supplyProjector projects the minter and the total supply over time as the
+// BeginBlocker would update them if blocks were produced continuously.
+type supplyProjector struct {
+	ctx     sdk.Context
+	genesis time.Time
+	params  Params
+	minter  Minter
+	supply  sdk.Int
+	time    time.Time
+}
+
+// advance mints the provision from the time of the projector to the time to
+// and then updates the minter.
+func (p *supplyProjector) advance(to time.Time) error {
+	provision, err := p.minter.CalculateBlockProvision(to, p.time)
+	if err != nil {
+		return err
+	}
+	p.supply = p.supply.Add(provision.Amount)
+	p.time = to
+	p.updateMinter()
+	return nil
+}
+
+// updateMinter updates the inflation rate and the annual provisions of the
+// minter if the inflation rate has changed, as the BeginBlocker does.
+func (p *supplyProjector) updateMinter() {
+	inflationRate := p.minter.CalculateInflationRate(p.ctx.WithBlockTime(p.time), p.genesis, p.params)
+	if inflationRate.Equal(p.minter.InflationRate) && !p.minter.AnnualProvisions.IsZero() {
+		return
+	}
+	p.minter.InflationRate = inflationRate
+	p.minter.AnnualProvisions = inflationRate.MulInt(p.supply)
+}
+
+// projection returns the current state of the projector.
+func (p *supplyProjector) projection() SupplyProjection {
+	return SupplyProjection{
+		Year:             yearsSinceGenesis(p.genesis, p.time),
+		Time:             p.time,
+		TotalSupply:      p.supply,
+		InflationRate:    p.minter.InflationRate,
+		AnnualProvisions: p.minter.AnnualProvisions,
+	}
+}
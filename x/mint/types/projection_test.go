@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestProjectSupply(t *testing.T) {
+	genesisTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	oneYear := time.Duration(NanosecondsPerYear)
+	supply := sdk.NewInt(1_000_000_000_000)
+	// the minter at genesis before the first BeginBlocker
+	minter := DefaultMinter()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil).WithBlockTime(genesisTime)
+
+	t.Run("years", func(t *testing.T) {
+		projections, atTime, err := minter.ProjectSupply(ctx, genesisTime, DefaultParams(), supply, 3, nil)
+		require.NoError(t, err)
+		require.Nil(t, atTime)
+		require.Len(t, projections, 3)
+
+		wantSupply := supply
+		wantRate := InitialInflationRateAsDec()
+		for i, projection := range projections {
+			year := int64(i + 1)
+			wantSupply = wantSupply.Add(wantRate.MulInt(wantSupply).TruncateInt())
+			wantRate = wantRate.Mul(sdk.OneDec().Sub(DisinflationRateAsDec()))
+
+			assert.Equal(t, year, projection.Year)
+			assert.Equal(t, genesisTime.Add(time.Duration(year)*oneYear), projection.Time)
+			assert.Equal(t, wantSupply, projection.TotalSupply, "year %d", year)
+			assert.Equal(t, wantRate, projection.InflationRate, "year %d", year)
+			assert.Equal(t, wantRate.MulInt(wantSupply), projection.AnnualProvisions, "year %d", year)
+		}
+	})
+
+	t.Run("time", func(t *testing.T) {
+		projections, _, err := minter.ProjectSupply(ctx, genesisTime, DefaultParams(), supply, 2, nil)
+		require.NoError(t, err)
+
+		// at a genesis anniversary the projection is the one of the year
+		at := genesisTime.Add(2 * oneYear)
+		_, atTime, err := minter.ProjectSupply(ctx, genesisTime, DefaultParams(), supply, 0, &at)
+		require.NoError(t, err)
+		require.NotNil(t, atTime)
+		assert.Equal(t, projections[1], *atTime)
+
+		// half way through a year half of the annual provisions are minted
+		at = genesisTime.Add(oneYear + oneYear/2)
+		_, atTime, err = minter.ProjectSupply(ctx, genesisTime, DefaultParams(), supply, 0, &at)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), atTime.Year)
+		assert.Equal(t, projections[0].InflationRate, atTime.InflationRate)
+		assert.Equal(t, projections[0].AnnualProvisions, atTime.AnnualProvisions)
+		wantSupply := projections[0].TotalSupply.Add(projections[0].AnnualProvisions.QuoInt64(2).TruncateInt())
+		assert.Equal(t, wantSupply, atTime.TotalSupply)
+
+		// the current time is the current state
+		_, atTime, err = minter.ProjectSupply(ctx, genesisTime, DefaultParams(), supply, 0, &genesisTime)
+		require.NoError(t, err)
+		assert.Equal(t, supply, atTime.TotalSupply)
+		assert.Equal(t, InitialInflationRateAsDec(), atTime.InflationRate)
+	})
+
+	t.Run("params", func(t *testing.T) {
+		params := NewParams(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(2, 2))
+		projections, _, err := minter.ProjectSupply(ctx, genesisTime, params, supply, 4, nil)
+		require.NoError(t, err)
+		want := []sdk.Dec{sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(25, 3), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(2, 2)}
+		for i, projection := range projections {
+			assert.Equal(t, want[i], projection.InflationRate, "year %d", projection.Year)
+		}
+	})
+
+	t.Run("time before the current block", func(t *testing.T) {
+		at := genesisTime.Add(-time.Second)
+		_, _, err := minter.ProjectSupply(ctx, genesisTime, DefaultParams(), supply, 0, &at)
+		assert.Error(t, err)
+	})
+}
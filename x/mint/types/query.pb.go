@@ -7,7 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/gogoproto/gogoproto"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -275,6 +275,263 @@ func (m *QueryGenesisTimeResponse) GetGenesisTime() *time.Time {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{6}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// Params are the parameters of the inflation schedule.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QuerySupplyProjectionRequest is the request type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionRequest struct {
+	// Years is the number of genesis anniversaries after the current block to
+	// project the supply at.
+	Years uint32 `protobuf:"varint,1,opt,name=years,proto3" json:"years,omitempty"`
+	// Time is an optional future time to project the supply at.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *QuerySupplyProjectionRequest) Reset()         { *m = QuerySupplyProjectionRequest{} }
+func (m *QuerySupplyProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionRequest) ProtoMessage()    {}
+func (*QuerySupplyProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{8}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionRequest.Merge(m, src)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionRequest) GetYears() uint32 {
+	if m != nil {
+		return m.Years
+	}
+	return 0
+}
+
+func (m *QuerySupplyProjectionRequest) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// QuerySupplyProjectionResponse is the response type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionResponse struct {
+	// Years are the projections at each of the requested genesis anniversaries.
+	Years []SupplyProjection `protobuf:"bytes,1,rep,name=years,proto3" json:"years"`
+	// AtTime is the projection at the requested time, if any.
+	AtTime *SupplyProjection `protobuf:"bytes,2,opt,name=at_time,json=atTime,proto3" json:"at_time,omitempty"`
+}
+
+func (m *QuerySupplyProjectionResponse) Reset()         { *m = QuerySupplyProjectionResponse{} }
+func (m *QuerySupplyProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionResponse) ProtoMessage()    {}
+func (*QuerySupplyProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{9}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionResponse.Merge(m, src)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionResponse) GetYears() []SupplyProjection {
+	if m != nil {
+		return m.Years
+	}
+	return nil
+}
+
+func (m *QuerySupplyProjectionResponse) GetAtTime() *SupplyProjection {
+	if m != nil {
+		return m.AtTime
+	}
+	return nil
+}
+
+// SupplyProjection is the projected state of the mint module at a time.
+type SupplyProjection struct {
+	// Year is the number of years since genesis at Time.
+	Year int64 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Time is the time of the projection.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// TotalSupply is the projected total supply of the bond denom at Time.
+	TotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_supply,json=totalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_supply"`
+	// InflationRate is the projected inflation rate at Time.
+	InflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflation_rate,json=inflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate"`
+	// AnnualProvisions is the projected annual provisions at Time.
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+}
+
+func (m *SupplyProjection) Reset()         { *m = SupplyProjection{} }
+func (m *SupplyProjection) String() string { return proto.CompactTextString(m) }
+func (*SupplyProjection) ProtoMessage()    {}
+func (*SupplyProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{10}
+}
+func (m *SupplyProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyProjection.Merge(m, src)
+}
+func (m *SupplyProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyProjection proto.InternalMessageInfo
+
+func (m *SupplyProjection) GetYear() int64 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *SupplyProjection) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "celestia.mint.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "celestia.mint.v1.QueryInflationRateResponse")
@@ -282,44 +539,64 @@ func init() {
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "celestia.mint.v1.QueryAnnualProvisionsResponse")
 	proto.RegisterType((*QueryGenesisTimeRequest)(nil), "celestia.mint.v1.QueryGenesisTimeRequest")
 	proto.RegisterType((*QueryGenesisTimeResponse)(nil), "celestia.mint.v1.QueryGenesisTimeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.mint.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.mint.v1.QueryParamsResponse")
+	proto.RegisterType((*QuerySupplyProjectionRequest)(nil), "celestia.mint.v1.QuerySupplyProjectionRequest")
+	proto.RegisterType((*QuerySupplyProjectionResponse)(nil), "celestia.mint.v1.QuerySupplyProjectionResponse")
+	proto.RegisterType((*SupplyProjection)(nil), "celestia.mint.v1.SupplyProjection")
 }
 
 func init() { proto.RegisterFile("celestia/mint/v1/query.proto", fileDescriptor_a1ed5b0ae449a133) }
 
 var fileDescriptor_a1ed5b0ae449a133 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4d, 0x6e, 0x13, 0x31,
-	0x1c, 0xc5, 0x63, 0xbe, 0x16, 0x4e, 0x8b, 0x82, 0x85, 0x44, 0x3b, 0x0d, 0x13, 0x98, 0x8a, 0xaa,
-	0xa5, 0xd4, 0x26, 0xe5, 0x04, 0x04, 0x24, 0x04, 0x2b, 0x88, 0xca, 0x06, 0x16, 0x91, 0x13, 0x5c,
-	0x63, 0x91, 0xb1, 0xdd, 0xb1, 0x27, 0xa2, 0x12, 0x2b, 0x2e, 0x40, 0x25, 0x16, 0x1c, 0x80, 0xcb,
-	0x74, 0x59, 0x89, 0x0d, 0xb0, 0x28, 0x28, 0xe1, 0x20, 0x68, 0x3c, 0x9e, 0x90, 0xaf, 0x91, 0x22,
-	0x56, 0x71, 0xe6, 0xfd, 0xe7, 0xff, 0x7e, 0xe3, 0xf7, 0x60, 0xbd, 0xc7, 0xfa, 0xcc, 0x58, 0x41,
-	0x49, 0x2c, 0xa4, 0x25, 0x83, 0x26, 0x39, 0x4a, 0x59, 0x72, 0x8c, 0x75, 0xa2, 0xac, 0x42, 0xb5,
-	0x42, 0xc5, 0x99, 0x8a, 0x07, 0xcd, 0xe0, 0x3a, 0x57, 0x5c, 0x39, 0x91, 0x64, 0xa7, 0x7c, 0x2e,
-	0xa8, 0x73, 0xa5, 0x78, 0x9f, 0x11, 0xaa, 0x05, 0xa1, 0x52, 0x2a, 0x4b, 0xad, 0x50, 0xd2, 0x78,
-	0x75, 0x63, 0xce, 0xc3, 0x6d, 0xcb, 0xc5, 0x86, 0x7f, 0xd5, 0xfd, 0xeb, 0xa6, 0x87, 0xc4, 0x8a,
-	0x98, 0x19, 0x4b, 0x63, 0x9d, 0x0f, 0x44, 0x1b, 0x70, 0xfd, 0x45, 0x86, 0xf4, 0x54, 0x1e, 0xf6,
-	0xdd, 0xda, 0x36, 0xb5, 0xac, 0xcd, 0x8e, 0x52, 0x66, 0x6c, 0x64, 0x60, 0xb0, 0x48, 0x34, 0x5a,
-	0x49, 0xc3, 0xd0, 0x4b, 0x78, 0x55, 0x14, 0x42, 0x27, 0xa1, 0x96, 0xad, 0x81, 0x5b, 0x60, 0x7b,
-	0xa5, 0x85, 0x4f, 0xcf, 0x1b, 0x95, 0x9f, 0xe7, 0x8d, 0x2d, 0x2e, 0xec, 0xdb, 0xb4, 0x8b, 0x7b,
-	0x2a, 0x26, 0x3d, 0x65, 0x62, 0x65, 0xfc, 0xcf, 0x9e, 0x79, 0xf3, 0x8e, 0xd8, 0x63, 0xcd, 0x0c,
-	0x7e, 0xcc, 0x7a, 0xed, 0x55, 0x31, 0xb9, 0x3e, 0x0a, 0x61, 0xdd, 0x99, 0x3e, 0x94, 0x32, 0xa5,
-	0xfd, 0xe7, 0x89, 0x1a, 0x08, 0x93, 0x7d, 0x6e, 0x01, 0xf5, 0x01, 0xde, 0x2c, 0xd1, 0x3d, 0xd7,
-	0x6b, 0x78, 0x8d, 0x3a, 0xad, 0xa3, 0xc7, 0xe2, 0x7f, 0xa2, 0xd5, 0xe8, 0x8c, 0x49, 0xb4, 0x0e,
-	0x6f, 0x38, 0xf7, 0x27, 0x4c, 0x32, 0x23, 0xcc, 0x81, 0x88, 0xc7, 0xb7, 0xd5, 0x81, 0x6b, 0xf3,
-	0x92, 0x67, 0x7a, 0x04, 0x57, 0x78, 0xfe, 0xb8, 0x93, 0x25, 0xe0, 0x70, 0xaa, 0xfb, 0x01, 0xce,
-	0xe3, 0xc1, 0x45, 0x3c, 0xf8, 0xa0, 0x88, 0xa7, 0x75, 0xe9, 0xe4, 0x57, 0x03, 0xb4, 0xab, 0xfc,
-	0xdf, 0xb2, 0xfd, 0x1f, 0x17, 0xe1, 0x65, 0xe7, 0x80, 0xbe, 0x00, 0xb8, 0x3a, 0x15, 0x0a, 0xda,
-	0xc5, 0xb3, 0x65, 0xc2, 0xa5, 0xb9, 0x06, 0xf7, 0x96, 0x1b, 0xce, 0xd9, 0xa3, 0xdd, 0x8f, 0xdf,
-	0xfe, 0x7c, 0xbe, 0x70, 0x07, 0x6d, 0x16, 0x57, 0xe5, 0x7b, 0xd6, 0x65, 0x96, 0x36, 0xc9, 0x74,
-	0x05, 0xd0, 0x57, 0x00, 0x6b, 0xb3, 0xc9, 0x20, 0x5c, 0xe2, 0x57, 0x12, 0x71, 0x40, 0x96, 0x9e,
-	0xf7, 0x88, 0xd8, 0x21, 0x6e, 0xa3, 0xad, 0x85, 0x88, 0x73, 0x6d, 0x40, 0x9f, 0x00, 0xac, 0x4e,
-	0xc4, 0x84, 0x76, 0x4a, 0x0c, 0xe7, 0x53, 0x0e, 0xee, 0x2e, 0x33, 0xea, 0xb1, 0x76, 0x1c, 0xd6,
-	0x26, 0xba, 0xbd, 0x10, 0x6b, 0xb2, 0x10, 0xad, 0x67, 0xa7, 0xc3, 0x10, 0x9c, 0x0d, 0x43, 0xf0,
-	0x7b, 0x18, 0x82, 0x93, 0x51, 0x58, 0x39, 0x1b, 0x85, 0x95, 0xef, 0xa3, 0xb0, 0xf2, 0xea, 0xfe,
-	0x64, 0x57, 0xbd, 0xb5, 0x4a, 0xf8, 0xf8, 0xbc, 0x47, 0xb5, 0x26, 0xef, 0xf3, 0xd5, 0xae, 0xb9,
-	0xdd, 0x2b, 0xae, 0x4e, 0x0f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xb5, 0x7b, 0x2f, 0x7e,
-	0x04, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8d, 0xdb, 0x24, 0xef, 0x69, 0xd2, 0x3e, 0xe5, 0x0d, 0x95, 0x48, 0xdd, 0xd6, 0x29, 0x2e,
+	0xad, 0x5a, 0x4a, 0x3d, 0xb4, 0x20, 0x84, 0x84, 0x84, 0x44, 0x40, 0x42, 0x45, 0x42, 0x6a, 0x4d,
+	0xd9, 0xc0, 0xc2, 0x9a, 0x84, 0xa9, 0x71, 0x89, 0x3d, 0xae, 0x67, 0x52, 0x88, 0xc4, 0x8a, 0x1f,
+	0xa0, 0x12, 0x0b, 0x36, 0x2c, 0x90, 0xf8, 0x99, 0x2e, 0x2b, 0xb1, 0x41, 0x2c, 0x0a, 0x6a, 0x91,
+	0xf8, 0x02, 0xf6, 0xc8, 0x33, 0x93, 0x34, 0x89, 0x63, 0x61, 0x50, 0x57, 0xb1, 0x7d, 0xee, 0xdc,
+	0x73, 0xee, 0xbd, 0x73, 0x4f, 0xc0, 0x74, 0x83, 0x34, 0x09, 0xe3, 0x1e, 0x46, 0xbe, 0x17, 0x70,
+	0xb4, 0xb7, 0x8a, 0x76, 0x5b, 0x24, 0x6a, 0x5b, 0x61, 0x44, 0x39, 0x85, 0xe5, 0x0e, 0x6a, 0xc5,
+	0xa8, 0xb5, 0xb7, 0xaa, 0x4f, 0xb8, 0xd4, 0xa5, 0x02, 0x44, 0xf1, 0x93, 0x8c, 0xd3, 0xa7, 0x5d,
+	0x4a, 0xdd, 0x26, 0x41, 0x38, 0xf4, 0x10, 0x0e, 0x02, 0xca, 0x31, 0xf7, 0x68, 0xc0, 0x14, 0x3a,
+	0x95, 0xe0, 0x10, 0xd9, 0x24, 0x58, 0x55, 0x47, 0xc5, 0x5b, 0xbd, 0xb5, 0x8d, 0xb8, 0xe7, 0x13,
+	0xc6, 0xb1, 0x1f, 0xca, 0x00, 0x73, 0x0a, 0x4c, 0x6e, 0xc6, 0x92, 0xd6, 0x83, 0xed, 0xa6, 0x48,
+	0x6b, 0x63, 0x4e, 0x6c, 0xb2, 0xdb, 0x22, 0x8c, 0x9b, 0x0c, 0xe8, 0xc3, 0x40, 0x16, 0xd2, 0x80,
+	0x11, 0xf8, 0x08, 0xfc, 0xe7, 0x75, 0x00, 0x27, 0xc2, 0x9c, 0x54, 0xb4, 0x59, 0x6d, 0x71, 0xac,
+	0x66, 0x1d, 0x1c, 0x55, 0x73, 0x5f, 0x8e, 0xaa, 0x0b, 0xae, 0xc7, 0x9f, 0xb5, 0xea, 0x56, 0x83,
+	0xfa, 0xa8, 0x41, 0x99, 0x4f, 0x99, 0xfa, 0x59, 0x61, 0x4f, 0x9f, 0x23, 0xde, 0x0e, 0x09, 0xb3,
+	0xee, 0x92, 0x86, 0x3d, 0xee, 0xf5, 0xa6, 0x37, 0x0d, 0x30, 0x2d, 0x48, 0x6f, 0x07, 0x41, 0x0b,
+	0x37, 0x37, 0x22, 0xba, 0xe7, 0xb1, 0xb8, 0xdc, 0x8e, 0xa8, 0x57, 0x60, 0x26, 0x05, 0x57, 0xba,
+	0x9e, 0x80, 0xff, 0xb1, 0xc0, 0x9c, 0xb0, 0x0b, 0xfe, 0xa5, 0xb4, 0x32, 0x1e, 0x20, 0x31, 0x27,
+	0xc1, 0x79, 0xc1, 0x7e, 0x8f, 0x04, 0x84, 0x79, 0x6c, 0xcb, 0xf3, 0xbb, 0xdd, 0x72, 0x40, 0x25,
+	0x09, 0x29, 0x4d, 0x77, 0xc0, 0x98, 0x2b, 0x3f, 0x3b, 0xf1, 0x04, 0x84, 0x9c, 0xd2, 0x9a, 0x6e,
+	0xc9, 0xf1, 0x58, 0x9d, 0xf1, 0x58, 0x5b, 0x9d, 0xf1, 0xd4, 0xf2, 0xfb, 0x5f, 0xab, 0x9a, 0x5d,
+	0x72, 0x4f, 0x93, 0x99, 0x13, 0x00, 0x0a, 0x82, 0x0d, 0x1c, 0x61, 0xbf, 0xdb, 0x8f, 0x07, 0xe0,
+	0x5c, 0xdf, 0x57, 0xc5, 0x78, 0x1d, 0x14, 0x43, 0xf1, 0x45, 0x71, 0x55, 0xac, 0xc1, 0xdb, 0x66,
+	0xc9, 0x13, 0xb5, 0x7c, 0xdc, 0x14, 0x5b, 0x45, 0x9b, 0x3b, 0xaa, 0xfd, 0x0f, 0x5b, 0x61, 0xd8,
+	0x6c, 0x6f, 0x44, 0x74, 0x87, 0x34, 0xc4, 0x6c, 0x24, 0x1d, 0x9c, 0x00, 0x85, 0x36, 0xc1, 0x91,
+	0x4c, 0x3b, 0x6e, 0xcb, 0x17, 0x78, 0x0d, 0xe4, 0x45, 0x5d, 0x23, 0x19, 0xeb, 0x12, 0xd1, 0xe6,
+	0x7b, 0x0d, 0xcc, 0xa4, 0x90, 0xa9, 0x2a, 0x6e, 0x9d, 0xb2, 0x8d, 0x2e, 0x96, 0xd6, 0xcc, 0x64,
+	0x11, 0x83, 0x47, 0x55, 0x39, 0x4a, 0xd7, 0x4d, 0xf0, 0x0f, 0xe6, 0x4e, 0x8f, 0xb4, 0x0c, 0x19,
+	0xec, 0x22, 0xe6, 0xa2, 0xdf, 0x3f, 0x46, 0x40, 0x79, 0x10, 0x84, 0x10, 0xe4, 0xe3, 0xd4, 0xa2,
+	0xfc, 0x51, 0x5b, 0x3c, 0xc3, 0x1b, 0x99, 0xab, 0xff, 0x37, 0x16, 0x77, 0xda, 0x01, 0xb8, 0x09,
+	0xc6, 0x38, 0xe5, 0xb8, 0xe9, 0x30, 0xc1, 0x53, 0x19, 0xfd, 0xe3, 0x6b, 0xba, 0x1e, 0x70, 0xbb,
+	0x24, 0x72, 0x48, 0xa9, 0x43, 0xd6, 0x32, 0x7f, 0x06, 0x6b, 0x39, 0x7c, 0xab, 0x0a, 0x67, 0xb3,
+	0x55, 0x6b, 0x3f, 0x0b, 0xa0, 0x20, 0x2e, 0x02, 0x7c, 0xa7, 0x81, 0xf1, 0x3e, 0xbb, 0x81, 0xcb,
+	0xc9, 0x89, 0xa5, 0x3a, 0x96, 0x7e, 0x39, 0x5b, 0xb0, 0xbc, 0x5d, 0xe6, 0xf2, 0xeb, 0x4f, 0xdf,
+	0xdf, 0x8e, 0xcc, 0xc3, 0xb9, 0x8e, 0x5c, 0xe5, 0xa0, 0x75, 0xc2, 0xf1, 0x2a, 0xea, 0xef, 0x22,
+	0xfc, 0xa8, 0x81, 0xf2, 0xa0, 0xe7, 0x40, 0x2b, 0x85, 0x2f, 0xc5, 0xbc, 0x74, 0x94, 0x39, 0x5e,
+	0x49, 0xb4, 0x84, 0xc4, 0x45, 0xb8, 0x30, 0x54, 0x62, 0x62, 0x22, 0xf0, 0x8d, 0x06, 0x4a, 0x3d,
+	0x06, 0x04, 0x97, 0x52, 0x08, 0x93, 0xfe, 0xa5, 0x5f, 0xca, 0x12, 0xaa, 0x64, 0x2d, 0x09, 0x59,
+	0x73, 0xf0, 0xc2, 0x50, 0x59, 0xbd, 0x56, 0x07, 0x5f, 0x80, 0xa2, 0x34, 0x1a, 0x78, 0x31, 0x85,
+	0xa0, 0xcf, 0xcf, 0xf4, 0xf9, 0xdf, 0x44, 0x29, 0x05, 0xb3, 0x42, 0x81, 0x0e, 0x2b, 0x28, 0xf1,
+	0xff, 0x27, 0x9d, 0x0c, 0x7e, 0xd0, 0x86, 0xac, 0x6f, 0xda, 0xc0, 0x52, 0xec, 0x4e, 0x47, 0x99,
+	0xe3, 0x93, 0x77, 0x6a, 0x50, 0x97, 0xdc, 0x71, 0x27, 0xec, 0x1e, 0xaa, 0xdd, 0x3f, 0x38, 0x36,
+	0xb4, 0xc3, 0x63, 0x43, 0xfb, 0x76, 0x6c, 0x68, 0xfb, 0x27, 0x46, 0xee, 0xf0, 0xc4, 0xc8, 0x7d,
+	0x3e, 0x31, 0x72, 0x8f, 0xaf, 0xf4, 0xee, 0x92, 0x4a, 0x44, 0x23, 0xb7, 0xfb, 0xbc, 0x82, 0xc3,
+	0x10, 0xbd, 0x94, 0xa9, 0xc5, 0x66, 0xd5, 0x8b, 0xc2, 0x6e, 0xae, 0xfe, 0x1a, 0x00, 0xe8, 0x28,
+	0xae, 0x67, 0x74, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +617,11 @@ type QueryClient interface {
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(ctx context.Context, in *QueryGenesisTimeRequest, opts ...grpc.CallOption) (*QueryGenesisTimeResponse, error)
+	// Params returns the parameters of the inflation schedule.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SupplyProjection returns the projected total supply, annual provisions and
+	// inflation rate for each of the next years and at a future time.
+	SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -377,6 +659,24 @@ func (c *queryClient) GenesisTime(ctx context.Context, in *QueryGenesisTimeReque
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error) {
+	out := new(QuerySupplyProjectionResponse)
+	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/SupplyProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// InflationRate returns the current inflation rate.
@@ -385,6 +685,11 @@ type QueryServer interface {
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(context.Context, *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error)
+	// Params returns the parameters of the inflation schedule.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SupplyProjection returns the projected total supply, annual provisions and
+	// inflation rate for each of the next years and at a future time.
+	SupplyProjection(context.Context, *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -400,6 +705,12 @@ func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) GenesisTime(ctx context.Context, req *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenesisTime not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) SupplyProjection(ctx context.Context, req *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -459,6 +770,42 @@ func _Query_GenesisTime_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.mint.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.mint.v1.Query/SupplyProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyProjection(ctx, req.(*QuerySupplyProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.mint.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -475,6 +822,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenesisTime",
 			Handler:    _Query_GenesisTime_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SupplyProjection",
+			Handler:    _Query_SupplyProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/mint/v1/query.proto",
@@ -648,67 +1003,276 @@ func (m *QueryGenesisTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryInflationRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInflationRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.InflationRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAnnualProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAnnualProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGenesisTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGenesisTimeResponse) Size() (n int) {
+func (m *QuerySupplyProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Years != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AtTime != nil {
+		{
+			size, err := m.AtTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Years) > 0 {
+		for iNdEx := len(m.Years) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Years[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Year != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInflationRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGenesisTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGenesisTimeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -721,6 +1285,81 @@ func (m *QueryGenesisTimeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupplyProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Years != 0 {
+		n += 1 + sovQuery(uint64(m.Years))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Years) > 0 {
+		for _, e := range m.Years {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AtTime != nil {
+		l = m.AtTime.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SupplyProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Year != 0 {
+		n += 1 + sovQuery(uint64(m.Year))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1129,6 +1768,565 @@ func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			m.Years = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Years |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Years = append(m.Years, SupplyProjection{})
+			if err := m.Years[len(m.Years)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AtTime == nil {
+				m.AtTime = &SupplyProjection{}
+			}
+			if err := m.AtTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			m.Year = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Year |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdRegisterNamespace())
+	cmd.AddCommand(CmdUpdateNamespaceWriters())
+	return cmd
+}
+
+func CmdRegisterNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register namespace [writer]...",
+		Short: "Register a namespace so that only its owner and writers can pay for blobs in it",
+		Long: `This command will submit a RegisterNamespace message on behalf of the
+sender, who pays the registration fee and becomes the owner of the namespace.
+The namespace must be a hex encoded string of 29 bytes: the namespace version
+followed by the namespace ID. The writers are the addresses, other than the
+owner, allowed to pay for blobs in the namespace.
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterNamespace(clientCtx.GetFromAddress(), namespace, args[1:])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUpdateNamespaceWriters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-writers namespace [writer]...",
+		Short: "Replace the writers of a namespace owned by the sender",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateNamespaceWriters(clientCtx.GetFromAddress(), namespace, args[1:])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseNamespace parses a hex encoded namespace.
+func parseNamespace(arg string) (share.Namespace, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(arg, "0x"))
+	if err != nil {
+		return share.Namespace{}, fmt.Errorf("failed to decode hex namespace: %w", err)
+	}
+	return share.NewNamespaceFromBytes(bz)
+}
@@ -0,0 +1,34 @@
+package namespace
+
+import (
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the namespace module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, registration := range genState.Registrations {
+		k.SetRegistration(ctx, registration)
+	}
+	for _, blobSigner := range genState.BlobSigners {
+		k.SetBlobSigner(ctx, blobSigner)
+	}
+}
+
+// ExportGenesis returns the namespace module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	k.IterateRegistrations(ctx, func(registration types.Registration) bool {
+		genesis.Registrations = append(genesis.Registrations, registration)
+		return false
+	})
+	k.IterateBlobSigners(ctx, func(blobSigner types.BlobSigner) bool {
+		genesis.BlobSigners = append(genesis.BlobSigners, blobSigner)
+		return false
+	})
+	return genesis
+}
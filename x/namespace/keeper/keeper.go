@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper handles all the state changes for the namespace module.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	paramStore paramtypes.Subspace
+	// bankKeeper is used to charge the registration fee.
+	bankKeeper BankKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	ps paramtypes.Subspace,
+	bankKeeper BankKeeper,
+) Keeper {
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramStore: ps,
+		bankKeeper: bankKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetRegistration returns the registration of the namespace and whether the
+// namespace is registered.
+func (k Keeper) GetRegistration(ctx sdk.Context, namespace []byte) (types.Registration, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RegistrationKey(namespace))
+	if bz == nil {
+		return types.Registration{}, false
+	}
+	var registration types.Registration
+	k.cdc.MustUnmarshal(bz, &registration)
+	return registration, true
+}
+
+// SetRegistration persists the registration of a namespace, replacing any
+// previous one.
+func (k Keeper) SetRegistration(ctx sdk.Context, registration types.Registration) {
+	ctx.KVStore(k.storeKey).Set(types.RegistrationKey(registration.Namespace), k.cdc.MustMarshal(&registration))
+}
+
+// IterateRegistrations calls cb for the registration of every namespace in
+// ascending order of namespace until cb returns true.
+func (k Keeper) IterateRegistrations(ctx sdk.Context, cb func(registration types.Registration) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegistrationKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var registration types.Registration
+		k.cdc.MustUnmarshal(iterator.Value(), &registration)
+		if cb(registration) {
+			return
+		}
+	}
+}
+
+// IsWriter returns true if the signer is allowed to pay for blobs in the
+// namespace. Anyone is allowed to pay for blobs in a namespace that isn't
+// registered.
+func (k Keeper) IsWriter(ctx sdk.Context, namespace []byte, signer string) bool {
+	registration, found := k.GetRegistration(ctx, namespace)
+	if !found {
+		return true
+	}
+	return registration.IsWriter(signer)
+}
+
+// RecordBlobSigner records that the signer paid for blobs in the namespace so
+// that it can register the namespace. Nothing is recorded for a registered
+// namespace as only its owner and writers can pay for blobs in it.
+func (k Keeper) RecordBlobSigner(ctx sdk.Context, namespace []byte, signer string) {
+	if _, found := k.GetRegistration(ctx, namespace); found {
+		return
+	}
+	k.SetBlobSigner(ctx, types.BlobSigner{Namespace: namespace, Signer: signer})
+}
+
+// SetBlobSigner persists that the signer paid for blobs in the namespace.
+func (k Keeper) SetBlobSigner(ctx sdk.Context, blobSigner types.BlobSigner) {
+	ctx.KVStore(k.storeKey).Set(types.BlobSignerKey(blobSigner.Namespace, blobSigner.Signer), k.cdc.MustMarshal(&blobSigner))
+}
+
+// HasBlobSigner returns true if the signer paid for blobs in the namespace
+// before it was registered.
+func (k Keeper) HasBlobSigner(ctx sdk.Context, namespace []byte, signer string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.BlobSignerKey(namespace, signer))
+}
+
+// hasBlobSigners returns true if any account paid for blobs in the namespace
+// before it was registered.
+func (k Keeper) hasBlobSigners(ctx sdk.Context, namespace []byte) bool {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobSignersKey(namespace)).Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// deleteBlobSigners deletes the accounts that paid for blobs in the namespace.
+func (k Keeper) deleteBlobSigners(ctx sdk.Context, namespace []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobSignersKey(namespace))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateBlobSigners calls cb for every account that paid for blobs in a
+// namespace that isn't registered until cb returns true.
+func (k Keeper) IterateBlobSigners(ctx sdk.Context, cb func(blobSigner types.BlobSigner) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobSignerKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var blobSigner types.BlobSigner
+		k.cdc.MustUnmarshal(iterator.Value(), &blobSigner)
+		if cb(blobSigner) {
+			return
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+func TestRegisterNamespace(t *testing.T) {
+	k, bank, ctx := createKeeper(t)
+	owner, writer := testnode.RandomAddress().(sdk.AccAddress), testnode.RandomAddress().String()
+	ns := namespace(1)
+
+	msg := types.NewMsgRegisterNamespace(owner, ns, []string{writer})
+	_, err := k.RegisterNamespace(ctx, msg)
+	require.NoError(t, err)
+
+	registration, found := k.GetRegistration(ctx, ns.Bytes())
+	require.True(t, found)
+	assert.Equal(t, types.Registration{Namespace: ns.Bytes(), Owner: owner.String(), Writers: []string{writer}}, registration)
+	assert.Equal(t, []feePayment{{owner, types.DefaultRegistrationFee}}, bank.payments)
+
+	events := ctx.EventManager().Events().ToABCIEvents()
+	require.Len(t, events, 1)
+	event, err := sdk.ParseTypedEvent(events[0])
+	require.NoError(t, err)
+	assert.Equal(t, &types.EventRegisterNamespace{Namespace: ns.Bytes(), Owner: owner.String()}, event)
+
+	// a namespace can only be registered once
+	other := testnode.RandomAddress().(sdk.AccAddress)
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(other, ns, nil))
+	assert.ErrorIs(t, err, types.ErrNamespaceAlreadyRegistered)
+	assert.Len(t, bank.payments, 1)
+
+	// the registration fails if the owner can't pay the fee
+	bank.err = sdkerrors.ErrInsufficientFunds
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(other, namespace(2), nil))
+	assert.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	_, found = k.GetRegistration(ctx, namespace(2).Bytes())
+	assert.False(t, found)
+
+	// no fee is charged if the registration fee is zero
+	bank.err = nil
+	k.SetParams(ctx, types.NewParams(sdk.NewInt64Coin(appconsts.BondDenom, 0)))
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(other, namespace(2), nil))
+	require.NoError(t, err)
+	assert.Len(t, bank.payments, 1)
+}
+
+func TestRegisterNamespaceInUse(t *testing.T) {
+	k, _, ctx := createKeeper(t)
+	rollup, squatter := testnode.RandomAddress().String(), testnode.RandomAddress().(sdk.AccAddress)
+	ns := namespace(1)
+	k.RecordBlobSigner(ctx, ns.Bytes(), rollup)
+
+	// only an account that paid for blobs in the namespace can register it
+	_, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(squatter, ns, nil))
+	assert.ErrorIs(t, err, types.ErrNamespaceInUse)
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(sdk.MustAccAddressFromBech32(rollup), ns, nil))
+	require.NoError(t, err)
+
+	// the blob signers of a registered namespace are no longer recorded
+	assert.False(t, k.HasBlobSigner(ctx, ns.Bytes(), rollup))
+	k.RecordBlobSigner(ctx, ns.Bytes(), rollup)
+	assert.False(t, k.HasBlobSigner(ctx, ns.Bytes(), rollup))
+
+	// the blob signers of other namespaces don't prevent the registration
+	k.RecordBlobSigner(ctx, namespace(2).Bytes(), rollup)
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(squatter, namespace(3), nil))
+	require.NoError(t, err)
+	assert.True(t, k.HasBlobSigner(ctx, namespace(2).Bytes(), rollup))
+}
+
+func TestUpdateNamespaceWriters(t *testing.T) {
+	k, _, ctx := createKeeper(t)
+	owner, writer := testnode.RandomAddress().(sdk.AccAddress), testnode.RandomAddress().String()
+	ns := namespace(1)
+
+	_, err := k.UpdateNamespaceWriters(ctx, types.NewMsgUpdateNamespaceWriters(owner, ns, []string{writer}))
+	assert.ErrorIs(t, err, types.ErrNamespaceNotRegistered)
+
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, ns, nil))
+	require.NoError(t, err)
+	assert.True(t, k.IsWriter(ctx, ns.Bytes(), owner.String()))
+	assert.False(t, k.IsWriter(ctx, ns.Bytes(), writer))
+
+	// only the owner can update the writers
+	other := testnode.RandomAddress().(sdk.AccAddress)
+	_, err = k.UpdateNamespaceWriters(ctx, types.NewMsgUpdateNamespaceWriters(other, ns, []string{other.String()}))
+	assert.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+
+	_, err = k.UpdateNamespaceWriters(ctx, types.NewMsgUpdateNamespaceWriters(owner, ns, []string{writer}))
+	require.NoError(t, err)
+	assert.True(t, k.IsWriter(ctx, ns.Bytes(), owner.String()))
+	assert.True(t, k.IsWriter(ctx, ns.Bytes(), writer))
+	assert.False(t, k.IsWriter(ctx, ns.Bytes(), other.String()))
+
+	// anyone can write to a namespace that isn't registered
+	assert.True(t, k.IsWriter(ctx, namespace(2).Bytes(), other.String()))
+}
+
+func TestQueryNamespaces(t *testing.T) {
+	k, _, ctx := createKeeper(t)
+	owners := make([]sdk.AccAddress, 3)
+	for i := range owners {
+		owners[i] = testnode.RandomAddress().(sdk.AccAddress)
+		_, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owners[i], namespace(byte(i)), nil))
+		require.NoError(t, err)
+	}
+
+	resp, err := k.Namespace(ctx, &types.QueryNamespaceRequest{Namespace: namespace(1).Bytes()})
+	require.NoError(t, err)
+	assert.Equal(t, owners[1].String(), resp.Registration.Owner)
+
+	_, err = k.Namespace(ctx, &types.QueryNamespaceRequest{Namespace: namespace(3).Bytes()})
+	assert.Error(t, err)
+
+	page, err := k.Namespaces(ctx, &types.QueryNamespacesRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, page.Registrations, 2)
+	assert.Equal(t, owners[0].String(), page.Registrations[0].Owner)
+	assert.Equal(t, owners[1].String(), page.Registrations[1].Owner)
+
+	page, err = k.Namespaces(ctx, &types.QueryNamespacesRequest{Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, page.Registrations, 1)
+	assert.Equal(t, owners[2].String(), page.Registrations[0].Owner)
+
+	params, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultParams(), params.Params)
+}
+
+// namespace returns a valid blob namespace whose last byte is b.
+func namespace(b byte) share.Namespace {
+	return share.MustNewV0Namespace(append(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize-1), b))
+}
+
+type feePayment struct {
+	payer sdk.AccAddress
+	fee   sdk.Coin
+}
+
+// mockBankKeeper records the registration fees paid to the fee collector.
+type mockBankKeeper struct {
+	payments []feePayment
+	err      error
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, sender sdk.AccAddress, module string, amt sdk.Coins) error {
+	if m.err != nil {
+		return m.err
+	}
+	if module != authtypes.FeeCollectorName || len(amt) != 1 {
+		return sdkerrors.ErrInvalidCoins
+	}
+	m.payments = append(m.payments, feePayment{sender, amt[0]})
+	return nil
+}
+
+func createKeeper(t *testing.T) (keeper.Keeper, *mockBankKeeper, sdk.Context) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tParamsKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, nil)
+
+	paramsSubspace := paramtypes.NewSubspace(cdc,
+		testutil.MakeTestCodec(),
+		paramsKey,
+		tParamsKey,
+		types.ModuleName,
+	)
+	bank := &mockBankKeeper{}
+	k := keeper.NewKeeper(cdc, storeKey, paramsSubspace, bank)
+	k.SetParams(ctx, types.DefaultParams())
+
+	return k, bank, ctx
+}
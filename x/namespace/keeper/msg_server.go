@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RegisterNamespace registers the namespace on behalf of the owner, who pays
+// the registration fee to the fee collector. If blobs were already paid for
+// in the namespace, only one of the accounts that paid for them can register
+// it so that a namespace in use can't be squatted.
+func (k Keeper) RegisterNamespace(goCtx context.Context, msg *types.MsgRegisterNamespace) (*types.MsgRegisterNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetRegistration(ctx, msg.Namespace); found {
+		return nil, types.ErrNamespaceAlreadyRegistered.Wrapf("namespace %X", msg.Namespace)
+	}
+	if k.hasBlobSigners(ctx, msg.Namespace) && !k.HasBlobSigner(ctx, msg.Namespace, msg.Owner) {
+		return nil, types.ErrNamespaceInUse.Wrapf("namespace %X, signer %s", msg.Namespace, msg.Owner)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if fee := k.RegistrationFee(ctx); fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetRegistration(ctx, types.Registration{
+		Namespace: msg.Namespace,
+		Owner:     msg.Owner,
+		Writers:   msg.Writers,
+	})
+	k.deleteBlobSigners(ctx, msg.Namespace)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRegisterNamespace{
+		Namespace: msg.Namespace,
+		Owner:     msg.Owner,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterNamespaceResponse{}, nil
+}
+
+// UpdateNamespaceWriters replaces the writers of the namespace if the signer
+// is its owner.
+func (k Keeper) UpdateNamespaceWriters(goCtx context.Context, msg *types.MsgUpdateNamespaceWriters) (*types.MsgUpdateNamespaceWritersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registration, found := k.GetRegistration(ctx, msg.Namespace)
+	if !found {
+		return nil, types.ErrNamespaceNotRegistered.Wrapf("namespace %X", msg.Namespace)
+	}
+	if registration.Owner != msg.Owner {
+		return nil, types.ErrNotNamespaceOwner.Wrapf("signer %s, owner %s", msg.Owner, registration.Owner)
+	}
+
+	registration.Writers = msg.Writers
+	k.SetRegistration(ctx, registration)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateNamespaceWriters{
+		Namespace: msg.Namespace,
+		Writers:   msg.Writers,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateNamespaceWritersResponse{}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams gets all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		k.RegistrationFee(ctx),
+	)
+}
+
+// SetParams sets the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
+
+// RegistrationFee returns the RegistrationFee param
+func (k Keeper) RegistrationFee(ctx sdk.Context) (res sdk.Coin) {
+	k.paramStore.Get(ctx, types.KeyRegistrationFee, &res)
+	return res
+}
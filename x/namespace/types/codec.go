@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// RegisterLegacyAminoCodec registers the namespace types on the provided
+// LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterNamespace{}, URLMsgRegisterNamespace, nil)
+	cdc.RegisterConcrete(&MsgUpdateNamespaceWriters{}, URLMsgUpdateNamespaceWriters, nil)
+}
+
+// RegisterInterfaces registers the namespace module types on the provided
+// registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRegisterNamespace{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateNamespaceWriters{})
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
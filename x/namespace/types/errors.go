@@ -0,0 +1,15 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidNamespace           = errors.Register(ModuleName, 2, "invalid namespace")
+	ErrNamespaceAlreadyRegistered = errors.Register(ModuleName, 3, "namespace is already registered")
+	ErrNamespaceNotRegistered     = errors.Register(ModuleName, 4, "namespace is not registered")
+	ErrInvalidWriters             = errors.Register(ModuleName, 5, "invalid namespace writers")
+	ErrNotNamespaceOwner          = errors.Register(ModuleName, 6, "signer is not the owner of the namespace")
+	ErrUnauthorizedWriter         = errors.Register(ModuleName, 7, "signer is not allowed to write to the namespace")
+	ErrNamespaceInUse             = errors.Register(ModuleName, 8, "blobs were paid for in the namespace by other accounts")
+)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default namespace genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	registered := make(map[string]struct{}, len(gs.Registrations))
+	for _, registration := range gs.Registrations {
+		if err := registration.Validate(); err != nil {
+			return err
+		}
+		if _, ok := registered[string(registration.Namespace)]; ok {
+			return fmt.Errorf("duplicate registration of namespace %X", registration.Namespace)
+		}
+		registered[string(registration.Namespace)] = struct{}{}
+	}
+	for _, blobSigner := range gs.BlobSigners {
+		if err := blobSigner.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+const (
+	ModuleName = "namespace"
+
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+	RouterKey    = ModuleName
+
+	URLMsgRegisterNamespace      = "/celestia.namespace.v1.Msg/RegisterNamespace"
+	URLMsgUpdateNamespaceWriters = "/celestia.namespace.v1.Msg/UpdateNamespaceWriters"
+)
+
+// RegistrationKeyPrefix is the prefix of the keys in the namespace store under
+// which the registration of each namespace is persisted.
+var RegistrationKeyPrefix = []byte{0x01}
+
+// RegistrationKey returns the key in the namespace store of the registration
+// of the namespace.
+func RegistrationKey(namespace []byte) []byte {
+	return append(append([]byte{}, RegistrationKeyPrefix...), namespace...)
+}
+
+// BlobSignerKeyPrefix is the prefix of the keys in the namespace store under
+// which the accounts that paid for blobs in each unregistered namespace are
+// persisted.
+var BlobSignerKeyPrefix = []byte{0x02}
+
+// BlobSignersKey returns the prefix of the keys in the namespace store of the
+// accounts that paid for blobs in the namespace.
+func BlobSignersKey(namespace []byte) []byte {
+	return append(append([]byte{}, BlobSignerKeyPrefix...), namespace...)
+}
+
+// BlobSignerKey returns the key in the namespace store recording that the
+// signer paid for blobs in the namespace.
+func BlobSignerKey(namespace []byte, signer string) []byte {
+	return append(BlobSignersKey(namespace), signer...)
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// MaxWriters is the maximum number of writers of a namespace. It bounds the
+// size of a registration which is read for every PFB in the namespace.
+const MaxWriters = 64
+
+var (
+	_ sdk.Msg            = &MsgRegisterNamespace{}
+	_ sdk.Msg            = &MsgUpdateNamespaceWriters{}
+	_ legacytx.LegacyMsg = &MsgRegisterNamespace{}
+	_ legacytx.LegacyMsg = &MsgUpdateNamespaceWriters{}
+)
+
+func NewMsgRegisterNamespace(owner sdk.AccAddress, namespace share.Namespace, writers []string) *MsgRegisterNamespace {
+	return &MsgRegisterNamespace{
+		Owner:     owner.String(),
+		Namespace: namespace.Bytes(),
+		Writers:   writers,
+	}
+}
+
+func (msg *MsgRegisterNamespace) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgRegisterNamespace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if err := ValidateNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateWriters(msg.Owner, msg.Writers)
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+func (msg *MsgRegisterNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements legacytx.LegacyMsg.
+func (msg *MsgRegisterNamespace) Route() string {
+	return RouterKey
+}
+
+// Type implements legacytx.LegacyMsg.
+func (msg *MsgRegisterNamespace) Type() string {
+	return URLMsgRegisterNamespace
+}
+
+func NewMsgUpdateNamespaceWriters(owner sdk.AccAddress, namespace share.Namespace, writers []string) *MsgUpdateNamespaceWriters {
+	return &MsgUpdateNamespaceWriters{
+		Owner:     owner.String(),
+		Namespace: namespace.Bytes(),
+		Writers:   writers,
+	}
+}
+
+func (msg *MsgUpdateNamespaceWriters) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgUpdateNamespaceWriters) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if err := ValidateNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateWriters(msg.Owner, msg.Writers)
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+func (msg *MsgUpdateNamespaceWriters) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements legacytx.LegacyMsg.
+func (msg *MsgUpdateNamespaceWriters) Route() string {
+	return RouterKey
+}
+
+// Type implements legacytx.LegacyMsg.
+func (msg *MsgUpdateNamespaceWriters) Type() string {
+	return URLMsgUpdateNamespaceWriters
+}
+
+// ValidateNamespace returns an error if the namespace can't be registered
+// because blobs can't be paid for in it.
+func ValidateNamespace(namespace []byte) error {
+	ns, err := share.NewNamespaceFromBytes(namespace)
+	if err != nil {
+		return ErrInvalidNamespace.Wrap(err.Error())
+	}
+	if err := ns.ValidateForBlob(); err != nil {
+		return ErrInvalidNamespace.Wrap(err.Error())
+	}
+	return nil
+}
+
+// ValidateWriters returns an error if the writers aren't distinct valid
+// addresses other than the owner or if there are more than MaxWriters of them.
+func ValidateWriters(owner string, writers []string) error {
+	if len(writers) > MaxWriters {
+		return ErrInvalidWriters.Wrapf("%d writers exceeds the maximum of %d", len(writers), MaxWriters)
+	}
+	seen := make(map[string]struct{}, len(writers))
+	for _, writer := range writers {
+		if _, err := sdk.AccAddressFromBech32(writer); err != nil {
+			return ErrInvalidWriters.Wrapf("writer %s: %v", writer, err)
+		}
+		if writer == owner {
+			return ErrInvalidWriters.Wrapf("owner %s is always allowed to write", owner)
+		}
+		if _, ok := seen[writer]; ok {
+			return ErrInvalidWriters.Wrapf("duplicate writer %s", writer)
+		}
+		seen[writer] = struct{}{}
+	}
+	return nil
+}
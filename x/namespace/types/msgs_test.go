@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v3/x/namespace/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgRegisterNamespaceValidateBasic(t *testing.T) {
+	owner := testnode.RandomAddress().(sdk.AccAddress)
+	writer := testnode.RandomAddress().String()
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+
+	tooManyWriters := make([]string, types.MaxWriters+1)
+	for i := range tooManyWriters {
+		tooManyWriters[i] = testnode.RandomAddress().String()
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterNamespace
+		wantErr error
+	}{
+		{
+			name: "valid without writers",
+			msg:  types.NewMsgRegisterNamespace(owner, ns, nil),
+		},
+		{
+			name: "valid with writers",
+			msg:  types.NewMsgRegisterNamespace(owner, ns, []string{writer}),
+		},
+		{
+			name:    "reserved namespace",
+			msg:     types.NewMsgRegisterNamespace(owner, share.TxNamespace, nil),
+			wantErr: types.ErrInvalidNamespace,
+		},
+		{
+			name:    "namespace of invalid length",
+			msg:     &types.MsgRegisterNamespace{Owner: owner.String(), Namespace: ns.Bytes()[1:]},
+			wantErr: types.ErrInvalidNamespace,
+		},
+		{
+			name:    "invalid writer",
+			msg:     types.NewMsgRegisterNamespace(owner, ns, []string{"celestia1invalid"}),
+			wantErr: types.ErrInvalidWriters,
+		},
+		{
+			name:    "owner as writer",
+			msg:     types.NewMsgRegisterNamespace(owner, ns, []string{owner.String()}),
+			wantErr: types.ErrInvalidWriters,
+		},
+		{
+			name:    "duplicate writers",
+			msg:     types.NewMsgRegisterNamespace(owner, ns, []string{writer, writer}),
+			wantErr: types.ErrInvalidWriters,
+		},
+		{
+			name:    "too many writers",
+			msg:     types.NewMsgRegisterNamespace(owner, ns, tooManyWriters),
+			wantErr: types.ErrInvalidWriters,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.wantErr)
+			// the same rules apply when updating the writers
+			update := &types.MsgUpdateNamespaceWriters{Owner: tc.msg.Owner, Namespace: tc.msg.Namespace, Writers: tc.msg.Writers}
+			assert.ErrorIs(t, update.ValidateBasic(), tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyRegistrationFee = []byte("RegistrationFee")
+	// DefaultRegistrationFee is 10 TIA.
+	DefaultRegistrationFee = sdk.NewInt64Coin(appconsts.BondDenom, 10_000_000)
+)
+
+// ParamKeyTable returns the param key table for the namespace module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(registrationFee sdk.Coin) Params {
+	return Params{
+		RegistrationFee: registrationFee,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultRegistrationFee)
+}
+
+// ParamSetPairs gets the list of param key-value pairs
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRegistrationFee, &p.RegistrationFee, validateRegistrationFee),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validateRegistrationFee(p.RegistrationFee)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// validateRegistrationFee validates the RegistrationFee param
+func validateRegistrationFee(v interface{}) error {
+	fee, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid registration fee: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the registration isn't of a valid namespace by
+// a valid owner with valid writers.
+func (r Registration) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return err
+	}
+	if err := ValidateNamespace(r.Namespace); err != nil {
+		return err
+	}
+	return ValidateWriters(r.Owner, r.Writers)
+}
+
+// IsWriter returns true if the signer is allowed to pay for blobs in the
+// namespace, that is if it's the owner or one of the writers.
+func (r Registration) IsWriter(signer string) bool {
+	if signer == r.Owner {
+		return true
+	}
+	for _, writer := range r.Writers {
+		if signer == writer {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if the blob signer isn't a valid account of a
+// valid namespace.
+func (s BlobSigner) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Signer); err != nil {
+		return err
+	}
+	return ValidateNamespace(s.Namespace)
+}
@@ -9,6 +9,7 @@ import (
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	bsmoduletypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	minfeetypes "github.com/celestiaorg/celestia-app/v3/x/minfee"
+	minttypes "github.com/celestiaorg/celestia-app/v3/x/mint/types"
 	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -529,6 +530,45 @@ func (suite *GovParamsTestSuite) TestModifiableParams() {
 				assert.Equal(want, got)
 			},
 		},
+		{
+			"mint.InitialInflationRate",
+			testProposal(proposal.ParamChange{
+				Subspace: minttypes.ModuleName,
+				Key:      string(minttypes.KeyInitialInflationRate),
+				Value:    `"0.1"`,
+			}),
+			func() {
+				got := suite.app.MintKeeper.GetParams(suite.ctx).InitialInflationRate
+				want := sdk.MustNewDecFromStr("0.1")
+				assert.Equal(want, got)
+			},
+		},
+		{
+			"mint.DisinflationRate",
+			testProposal(proposal.ParamChange{
+				Subspace: minttypes.ModuleName,
+				Key:      string(minttypes.KeyDisinflationRate),
+				Value:    `"0.2"`,
+			}),
+			func() {
+				got := suite.app.MintKeeper.GetParams(suite.ctx).DisinflationRate
+				want := sdk.MustNewDecFromStr("0.2")
+				assert.Equal(want, got)
+			},
+		},
+		{
+			"mint.TargetInflationRate",
+			testProposal(proposal.ParamChange{
+				Subspace: minttypes.ModuleName,
+				Key:      string(minttypes.KeyTargetInflationRate),
+				Value:    `"0.02"`,
+			}),
+			func() {
+				got := suite.app.MintKeeper.GetParams(suite.ctx).TargetInflationRate
+				want := sdk.MustNewDecFromStr("0.02")
+				assert.Equal(want, got)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
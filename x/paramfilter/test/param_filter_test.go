@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	minttypes "github.com/celestiaorg/celestia-app/v3/x/mint/types"
 	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
@@ -12,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 )
 
 func TestParamFilter(t *testing.T) {
@@ -63,3 +67,51 @@ func TestParamFilter(t *testing.T) {
 func testProposal(changes ...proposal.ParamChange) *proposal.ParameterChangeProposal {
 	return proposal.NewParameterChangeProposal("title", "description", changes)
 }
+
+func TestParamsAddedInLaterVersion(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	handler := testApp.GovKeeper.LegacyRouter().GetRoute(proposal.RouterKey)
+	change := proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyTargetInflationRate), `"0.02"`)
+
+	// the mint params were added in v4 so they can't be changed before
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), types.Header{Version: version.Consensus{App: v3.Version}}, false, tmlog.NewNopLogger())
+	err := handler(ctx, testProposal(change))
+	require.ErrorIs(t, err, paramfilter.ErrBlockedParameter)
+	require.Equal(t, minttypes.DefaultParams(), testApp.MintKeeper.GetParams(ctx))
+
+	ctx = ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: v4.Version}})
+	require.NoError(t, handler(ctx, testProposal(change)))
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), testApp.MintKeeper.GetParams(ctx).TargetInflationRate)
+
+	// the bounds of the mint params are enforced
+	change = proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyTargetInflationRate), `"0.5"`)
+	require.Error(t, handler(ctx, testProposal(change)))
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), testApp.MintKeeper.GetParams(ctx).TargetInflationRate)
+}
+
+func TestMintParamsValidatedAsAWhole(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	handler := testApp.GovKeeper.LegacyRouter().GetRoute(proposal.RouterKey)
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), types.Header{Version: version.Consensus{App: v4.Version}}, false, tmlog.NewNopLogger())
+
+	// the target inflation rate can't be raised above the initial inflation
+	// rate even though it is within its bounds
+	target := proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyTargetInflationRate), `"0.1"`)
+	err := handler(ctx, testProposal(target))
+	require.ErrorIs(t, err, proposal.ErrSettingParameter)
+	require.Equal(t, minttypes.DefaultParams(), testApp.MintKeeper.GetParams(ctx))
+
+	// none of the changes of a proposal are applied if the resulting params
+	// are invalid
+	initial := proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyInitialInflationRate), `"0.09"`)
+	err = handler(ctx, testProposal(initial, target))
+	require.ErrorIs(t, err, proposal.ErrSettingParameter)
+	require.Equal(t, minttypes.DefaultParams(), testApp.MintKeeper.GetParams(ctx))
+
+	// it can be raised together with the initial inflation rate
+	initial = proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyInitialInflationRate), `"0.12"`)
+	require.NoError(t, handler(ctx, testProposal(target, initial)))
+	params := testApp.MintKeeper.GetParams(ctx)
+	require.Equal(t, sdk.MustNewDecFromStr("0.12"), params.InitialInflationRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), params.TargetInflationRate)
+}
@@ -1,8 +1,10 @@
 package cli_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
 	"github.com/celestiaorg/celestia-app/v3/x/signal/cli"
 	testutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -45,3 +47,32 @@ func (s *CLITestSuite) TestCmdGetUpgrade() {
 	s.Require().NoError(err)
 	s.Require().Contains(output.String(), "No upgrade is pending.")
 }
+
+func (s *CLITestSuite) TestCmdQueryReadiness() {
+	cmd := cli.CmdQueryReadiness()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "No version has been signalled for.")
+
+	cmd = cli.CmdQueryReadiness()
+	output, err = testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{"4"})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "Version 4 has 0 of the")
+	s.Require().Contains(output.String(), "Validators blocking quorum:")
+	s.Require().Contains(output.String(), "none")
+}
+
+func (s *CLITestSuite) TestCmdQuerySupportedVersions() {
+	cmd := cli.CmdQuerySupportedVersions()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "versions")
+}
+
+func (s *CLITestSuite) TestCmdSignalVersionRefusesUnsupportedVersion() {
+	cmd := cli.CmdSignalVersion()
+	for _, version := range []uint64{0, appconsts.LatestVersion + 1} {
+		_, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{fmt.Sprint(version)})
+		s.Require().ErrorContains(err, "is not supported by the node")
+	}
+}
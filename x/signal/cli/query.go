@@ -1,12 +1,16 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"strconv"
+	"text/tabwriter"
 
 	"github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 )
 
@@ -22,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdQueryTally())
 	cmd.AddCommand(CmdGetUpgrade())
+	cmd.AddCommand(CmdQueryReadiness())
+	cmd.AddCommand(CmdQuerySupportedVersions())
 	return cmd
 }
 
@@ -56,6 +62,32 @@ func CmdQueryTally() *cobra.Command {
 	return cmd
 }
 
+func CmdQuerySupportedVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supported-versions",
+		Short:   "Query for the app versions that the binary of the node supports",
+		Args:    cobra.NoArgs,
+		Example: "supported-versions",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.SupportedVersions(cmd.Context(), &types.QuerySupportedVersionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdGetUpgrade() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "upgrade",
@@ -84,3 +116,109 @@ func CmdGetUpgrade() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryReadiness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "readiness [version]",
+		Short: "Query for the validators that are blocking a version from reaching quorum",
+		Long: `This command prints the voting power that has signalled for a version
+and the bonded validators that haven't signalled for it, in descending order of
+voting power. If no version is given, the signalled version with the most
+voting power is used.
+`,
+		Args:    cobra.MaximumNArgs(1),
+		Example: "readiness 4",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			tallies, err := queryClient.VersionTallies(cmd.Context(), &types.QueryVersionTalliesRequest{})
+			if err != nil {
+				return err
+			}
+
+			var version uint64
+			if len(args) == 1 {
+				version, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			} else {
+				var power uint64
+				for _, tally := range tallies.Tallies {
+					if tally.VotingPower > power {
+						version, power = tally.Version, tally.VotingPower
+					}
+				}
+				if version == 0 {
+					return clientCtx.PrintString("No version has been signalled for.\n")
+				}
+			}
+
+			var signals []types.ValidatorSignal
+			pageReq := &query.PageRequest{}
+			for {
+				resp, err := queryClient.ValidatorSignals(cmd.Context(), &types.QueryValidatorSignalsRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+				signals = append(signals, resp.Signals...)
+				if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: resp.Pagination.NextKey}
+			}
+
+			return clientCtx.PrintString(readinessReport(version, tallies, signals))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// readinessReport returns the voting power that has signalled for version and
+// the validators, out of signals, that haven't signalled for it.
+func readinessReport(version uint64, tallies *types.QueryVersionTalliesResponse, signals []types.ValidatorSignal) string {
+	var power uint64
+	for _, tally := range tallies.Tallies {
+		if tally.Version == version {
+			power = tally.VotingPower
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Version %d has %d of the %d voting power required to reach quorum (total voting power %d).\n", version, power, tallies.ThresholdPower, tallies.TotalVotingPower)
+	if power >= tallies.ThresholdPower {
+		buf.WriteString("Quorum has been reached.\n")
+		return buf.String()
+	}
+	fmt.Fprintf(&buf, "%d more voting power is required to reach quorum.\n", tallies.ThresholdPower-power)
+
+	blocking := make([]types.ValidatorSignal, 0, len(signals))
+	for _, signal := range signals {
+		if signal.Version != version {
+			blocking = append(blocking, signal)
+		}
+	}
+	if len(blocking) == 0 {
+		return buf.String()
+	}
+	sort.SliceStable(blocking, func(i, j int) bool { return blocking[i].VotingPower > blocking[j].VotingPower })
+
+	buf.WriteString("\nValidators blocking quorum:\n")
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MONIKER\tOPERATOR ADDRESS\tSIGNALLED VERSION\tVOTING POWER")
+	for _, signal := range blocking {
+		signalled := "none"
+		if signal.Version != 0 {
+			signalled = strconv.FormatUint(signal.Version, 10)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", signal.Moniker, signal.OperatorAddress, signalled, signal.VotingPower)
+	}
+	w.Flush()
+	return buf.String()
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/celestiaorg/celestia-app/v3/x/signal/types"
@@ -24,6 +25,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdSignalVersion())
 	cmd.AddCommand(CmdTryUpgrade())
+	cmd.AddCommand(CmdCancelUpgrade())
 	return cmd
 }
 
@@ -42,6 +44,17 @@ func CmdSignalVersion() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			// Refuse to signal for a version that the node can't run as the
+			// validator wouldn't be able to follow the chain after the upgrade.
+			if !clientCtx.Offline {
+				resp, err := types.NewQueryClient(clientCtx).SupportedVersions(cmd.Context(), &types.QuerySupportedVersionsRequest{})
+				if err != nil {
+					return fmt.Errorf("querying the supported versions of the node: %w", err)
+				}
+				if !slices.Contains(resp.Versions, version) {
+					return fmt.Errorf("app version %d is not supported by the node, supported versions are %v", version, resp.Versions)
+				}
+			}
 
 			addr := clientCtx.GetFromAddress().Bytes()
 			valAddr := sdk.ValAddress(addr)
@@ -75,3 +88,25 @@ to the signalled version at the following height.
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdCancelUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-upgrade",
+		Short: "Cancel the pending software upgrade",
+		Long: `This command will submit a CancelUpgrade message to cancel the
+pending upgrade. The upgrade is cancelled if a quorum of voting power has
+signalled for the current version since the upgrade became pending.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCancelUpgrade(clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
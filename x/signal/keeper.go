@@ -4,13 +4,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"sort"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
 	"github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -40,29 +45,57 @@ type Keeper struct {
 	// store.
 	storeKey storetypes.StoreKey
 
+	// paramStore is used to fetch the params of the signal module.
+	paramStore paramtypes.Subspace
+
 	// stakingKeeper is used to fetch validators to calculate the total power
 	// signalled to a version.
 	stakingKeeper StakingKeeper
+
+	// authority is the address of the governance module account which can
+	// cancel a pending upgrade.
+	authority string
+
+	// supportedVersions returns the app versions that the binary supports.
+	supportedVersions func() []uint64
 }
 
 // NewKeeper returns a signal keeper.
 func NewKeeper(
 	binaryCodec codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	ps paramtypes.Subspace,
 	stakingKeeper StakingKeeper,
+	authority string,
 ) Keeper {
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		binaryCodec:   binaryCodec,
 		storeKey:      storeKey,
+		paramStore:    ps,
 		stakingKeeper: stakingKeeper,
+		authority:     authority,
 	}
 }
 
+// SetSupportedVersions sets the function that returns the app versions that
+// the binary supports.
+func (k *Keeper) SetSupportedVersions(supportedVersions func() []uint64) {
+	k.supportedVersions = supportedVersions
+}
+
 // SignalVersion is a method required by the MsgServer interface.
 func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion) (*types.MsgSignalVersionResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentVersion := sdkCtx.BlockHeader().Version.App
 
-	if k.IsUpgradePending(sdkCtx) {
+	// From v4, validators can signal for the current version while an upgrade
+	// is pending in order to cancel it.
+	isCancelSignal := currentVersion >= v4.Version && req.Version == currentVersion
+	if k.IsUpgradePending(sdkCtx) && !isCancelSignal {
 		return &types.MsgSignalVersionResponse{}, types.ErrUpgradePending.Wrapf("can not signal version")
 	}
 
@@ -72,7 +105,6 @@ func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion)
 	}
 
 	// The signalled version can not be less than the current version.
-	currentVersion := sdkCtx.BlockHeader().Version.App
 	if req.Version < currentVersion {
 		return nil, types.ErrInvalidSignalVersion.Wrapf("signalled version %d, current version %d", req.Version, currentVersion)
 	}
@@ -113,6 +145,38 @@ func (k *Keeper) TryUpgrade(ctx context.Context, _ *types.MsgTryUpgrade) (*types
 	return &types.MsgTryUpgradeResponse{}, nil
 }
 
+// CancelUpgrade is a method required by the MsgServer interface. It cancels
+// the pending upgrade if the signer is the governance module account or if a
+// quorum of voting power has signalled for the current version. The signals for
+// the version of the cancelled upgrade are deleted so that the upgrade isn't
+// scheduled again by the next MsgTryUpgrade. Upgrades can only be cancelled
+// from v4.
+func (k *Keeper) CancelUpgrade(ctx context.Context, req *types.MsgCancelUpgrade) (*types.MsgCancelUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	currentVersion := sdkCtx.BlockHeader().Version.App
+	if currentVersion < v4.Version {
+		return &types.MsgCancelUpgradeResponse{}, sdkerrors.ErrNotSupported.Wrapf("can not cancel an upgrade in app version %d", currentVersion)
+	}
+
+	upgrade, ok := k.getUpgrade(sdkCtx)
+	if !ok {
+		return &types.MsgCancelUpgradeResponse{}, types.ErrNoUpgradePending.Wrapf("can not cancel upgrade")
+	}
+
+	if req.Signer != k.authority {
+		threshold := k.GetVotingPowerThreshold(sdkCtx)
+		hasQuorum, version := k.TallyVotingPower(sdkCtx, threshold.Int64())
+		if !hasQuorum || version != currentVersion {
+			return &types.MsgCancelUpgradeResponse{}, types.ErrCancelQuorumNotReached.Wrapf("can not cancel upgrade to version %d", upgrade.AppVersion)
+		}
+	}
+
+	k.deleteUpgrade(sdkCtx)
+	k.deleteSignalsForVersion(sdkCtx, upgrade.AppVersion)
+	return &types.MsgCancelUpgradeResponse{}, nil
+}
+
 // VersionTally enables a client to query for the tally of voting power has
 // signalled for a particular version.
 func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRequest) (*types.QueryVersionTallyResponse, error) {
@@ -126,6 +190,9 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
 			continue
 		}
+		if k.isSignalExpired(sdkCtx, iterator.Value()) {
+			continue
+		}
 		valAddress := sdk.ValAddress(iterator.Key())
 		power := k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddress)
 		version := VersionFromBytes(iterator.Value())
@@ -141,10 +208,109 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 	}, nil
 }
 
-// SetValidatorVersion saves a signalled version for a validator.
+// ValidatorSignals enables a client to query for the version that each bonded
+// validator has signalled for along with its voting power. The validators are
+// ordered by operator address.
+func (k Keeper) ValidatorSignals(ctx context.Context, req *types.QueryValidatorSignalsRequest) (*types.QueryValidatorSignalsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	validators := make([]stakingtypes.Validator, 0)
+	for _, val := range k.stakingKeeper.GetLastValidators(sdkCtx) {
+		if val.IsBonded() {
+			validators = append(validators, val)
+		}
+	}
+	sort.Slice(validators, func(i, j int) bool {
+		return bytes.Compare(validators[i].GetOperator(), validators[j].GetOperator()) < 0
+	})
+
+	start, end, pageRes, err := paginate(len(validators), func(i int) []byte {
+		return validators[i].GetOperator()
+	}, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	signals := make([]types.ValidatorSignal, 0, end-start)
+	for _, val := range validators[start:end] {
+		valAddress := val.GetOperator()
+		version, _ := k.getValidatorVersion(sdkCtx, valAddress)
+		signals = append(signals, types.ValidatorSignal{
+			OperatorAddress: val.OperatorAddress,
+			Moniker:         val.GetMoniker(),
+			Version:         version,
+			VotingPower:     uint64(k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddress)),
+		})
+	}
+	return &types.QueryValidatorSignalsResponse{Signals: signals, Pagination: pageRes}, nil
+}
+
+// VersionTallies enables a client to query for the tally of voting power of
+// every version that bonded validators have signalled for.
+func (k Keeper) VersionTallies(ctx context.Context, _ *types.QueryVersionTalliesRequest) (*types.QueryVersionTalliesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	versionToPower := make(map[uint64]uint64)
+	store := sdkCtx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FirstSignalKey, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+			continue
+		}
+		if k.isSignalExpired(sdkCtx, iterator.Value()) {
+			continue
+		}
+		valAddress := sdk.ValAddress(iterator.Key())
+		val, found := k.stakingKeeper.GetValidator(sdkCtx, valAddress)
+		if !found || !val.IsBonded() {
+			continue
+		}
+		power := k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddress)
+		versionToPower[VersionFromBytes(iterator.Value())] += uint64(power)
+	}
+
+	tallies := make([]types.VersionTally, 0, len(versionToPower))
+	for version, power := range versionToPower {
+		tallies = append(tallies, types.VersionTally{Version: version, VotingPower: power})
+	}
+	sort.Slice(tallies, func(i, j int) bool { return tallies[i].Version < tallies[j].Version })
+
+	return &types.QueryVersionTalliesResponse{
+		Tallies:          tallies,
+		ThresholdPower:   k.GetVotingPowerThreshold(sdkCtx).Uint64(),
+		TotalVotingPower: k.stakingKeeper.GetLastTotalPower(sdkCtx).Uint64(),
+	}, nil
+}
+
+// SupportedVersions is a method required by the QueryServer interface.
+func (k Keeper) SupportedVersions(_ context.Context, _ *types.QuerySupportedVersionsRequest) (*types.QuerySupportedVersionsResponse, error) {
+	if k.supportedVersions == nil {
+		return &types.QuerySupportedVersionsResponse{}, nil
+	}
+	return &types.QuerySupportedVersionsResponse{Versions: k.supportedVersions()}, nil
+}
+
+// SetValidatorVersion saves a signalled version for a validator. From v4, the
+// height of the signal is saved along with the version so that the signal
+// expires.
 func (k Keeper) SetValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress, version uint64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(valAddress, VersionToBytes(version))
+	value := VersionToBytes(version)
+	if ctx.BlockHeader().Version.App >= v4.Version {
+		value = binary.BigEndian.AppendUint64(value, uint64(ctx.BlockHeight()))
+	}
+	store.Set(valAddress, value)
+}
+
+// getValidatorVersion returns the version that a validator has signalled for
+// and true. It returns 0 and false if the validator hasn't signalled or if its
+// signal has expired.
+func (k Keeper) getValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(valAddress)
+	if value == nil || k.isSignalExpired(ctx, value) {
+		return 0, false
+	}
+	return VersionFromBytes(value), true
 }
 
 // DeleteValidatorVersion deletes a signalled version for a validator.
@@ -153,6 +319,26 @@ func (k Keeper) DeleteValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddres
 	store.Delete(valAddress)
 }
 
+// deleteSignalsForVersion deletes the signals of all validators that have
+// signalled for the version.
+func (k Keeper) deleteSignalsForVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FirstSignalKey, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+			continue
+		}
+		if VersionFromBytes(iterator.Value()) == version {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // TallyVotingPower tallies the voting power for each version and returns true
 // and the version if any version has reached the quorum in voting power.
 // Returns false and 0 otherwise.
@@ -166,6 +352,11 @@ func (k Keeper) TallyVotingPower(ctx sdk.Context, threshold int64) (bool, uint64
 			continue
 		}
 		valAddress := sdk.ValAddress(iterator.Key())
+		// delete the signals that have expired
+		if k.isSignalExpired(ctx, iterator.Value()) {
+			k.DeleteValidatorVersion(ctx, valAddress)
+			continue
+		}
 		// check that the validator is still part of the bonded set
 		val, found := k.stakingKeeper.GetValidator(ctx, valAddress)
 		if !found {
@@ -235,6 +426,72 @@ func VersionFromBytes(version []byte) uint64 {
 	return binary.BigEndian.Uint64(version)
 }
 
+// isSignalExpired returns true if the signal saved as value is older than the
+// signal expiry. Signals don't expire before v4 and signals saved before v4
+// don't include their height and never expire.
+func (k Keeper) isSignalExpired(ctx sdk.Context, value []byte) bool {
+	if ctx.BlockHeader().Version.App < v4.Version || len(value) < 16 {
+		return false
+	}
+	height := int64(binary.BigEndian.Uint64(value[8:]))
+	return ctx.BlockHeight()-height >= k.SignalExpiry(ctx)
+}
+
+// SignalExpiry returns the number of blocks after which the signal of a
+// validator expires. The default signal expiry is returned if the param hasn't
+// been set.
+func (k Keeper) SignalExpiry(ctx sdk.Context) int64 {
+	expiry := types.DefaultSignalExpiry
+	k.paramStore.GetIfExists(ctx, types.KeySignalExpiry, &expiry)
+	return expiry
+}
+
+// SetParams sets the params of the signal module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
+
+// paginate returns the range [start, end) of the n items, whose keys are in
+// ascending order, that make up the page requested by pageReq.
+func paginate(n int, key func(i int) []byte, pageReq *query.PageRequest) (start, end int, pageRes *query.PageResponse, err error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset != 0 {
+		return 0, 0, nil, sdkerrors.ErrInvalidRequest.Wrap("either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return 0, 0, nil, sdkerrors.ErrInvalidRequest.Wrap("reverse pagination is not supported")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	if len(pageReq.Key) != 0 {
+		start = sort.Search(n, func(i int) bool { return bytes.Compare(key(i), pageReq.Key) >= 0 })
+	} else if pageReq.Offset < uint64(n) {
+		start = int(pageReq.Offset)
+	} else {
+		start = n
+	}
+	end = n
+	if limit < uint64(n-start) {
+		end = start + int(limit)
+	}
+
+	pageRes = &query.PageResponse{}
+	if end < n {
+		pageRes.NextKey = key(end)
+	}
+	if countTotal && len(pageReq.Key) == 0 {
+		pageRes.Total = uint64(n)
+	}
+	return start, end, pageRes, nil
+}
+
 // GetUpgrade returns the current upgrade information.
 func (k Keeper) GetUpgrade(ctx context.Context, _ *types.QueryGetUpgradeRequest) (*types.QueryGetUpgradeResponse, error) {
 	upgrade, ok := k.getUpgrade(sdk.UnwrapSDKContext(ctx))
@@ -272,3 +529,9 @@ func (k *Keeper) setUpgrade(ctx sdk.Context, upgrade types.Upgrade) {
 	value := k.binaryCodec.MustMarshal(&upgrade)
 	store.Set(types.UpgradeKey, value)
 }
+
+// deleteUpgrade deletes the upgrade from the store.
+func (k *Keeper) deleteUpgrade(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UpgradeKey)
+}
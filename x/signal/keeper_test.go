@@ -1,6 +1,7 @@
 package signal_test
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"math/big"
@@ -9,14 +10,21 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
 	"github.com/celestiaorg/celestia-app/v3/x/signal"
 	"github.com/celestiaorg/celestia-app/v3/x/signal/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -69,7 +77,8 @@ func TestGetVotingPowerThreshold(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 			stakingKeeper := newMockStakingKeeper(tc.validators)
-			k := signal.NewKeeper(config.Codec, nil, stakingKeeper)
+			subspace := paramtypes.NewSubspace(config.Codec, config.Amino, sdk.NewKVStoreKey(paramtypes.StoreKey), sdk.NewTransientStoreKey(paramtypes.TStoreKey), types.ModuleName)
+			k := signal.NewKeeper(config.Codec, nil, subspace, stakingKeeper, "")
 			got := k.GetVotingPowerThreshold(sdk.Context{})
 			assert.Equal(t, tc.want, got, fmt.Sprintf("want %v, got %v", tc.want.String(), got.String()))
 		})
@@ -466,11 +475,263 @@ func TestTallyAfterTryUpgrade(t *testing.T) {
 	require.EqualValues(t, 120, res.TotalVotingPower)
 }
 
+func TestCancelUpgrade(t *testing.T) {
+	// setupPendingUpgrade returns a v4 keeper with a pending upgrade to v5.
+	setupPendingUpgrade := func(t *testing.T) (signal.Keeper, sdk.Context) {
+		upgradeKeeper, ctx, _ := setup(t)
+		ctx = withAppVersion(ctx, v4.Version)
+		for _, valAddr := range testutil.ValAddrs[:4] {
+			_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: 5})
+			require.NoError(t, err)
+		}
+		_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+		return upgradeKeeper, ctx
+	}
+
+	t.Run("should return an error before v4", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		ctx = withAppVersion(ctx, v3.Version)
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authtypes.NewModuleAddress(govtypes.ModuleName)))
+		require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+	})
+
+	t.Run("should return an error if no upgrade is pending", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		ctx = withAppVersion(ctx, v4.Version)
+		_, err := upgradeKeeper.CancelUpgrade(ctx, &types.MsgCancelUpgrade{Signer: authority})
+		require.ErrorIs(t, err, types.ErrNoUpgradePending)
+	})
+
+	t.Run("should cancel the upgrade if the signer is the authority", func(t *testing.T) {
+		upgradeKeeper, ctx := setupPendingUpgrade(t)
+		_, err := upgradeKeeper.CancelUpgrade(ctx, &types.MsgCancelUpgrade{Signer: authority})
+		require.NoError(t, err)
+		require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+		shouldUpgrade, _ := upgradeKeeper.ShouldUpgrade(ctx.WithBlockHeight(math.MaxInt64))
+		require.False(t, shouldUpgrade)
+
+		// the signals for the cancelled version no longer count
+		_, err = upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+		res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 5})
+		require.NoError(t, err)
+		require.EqualValues(t, 0, res.VotingPower)
+	})
+
+	t.Run("should cancel the upgrade once a quorum signals the current version", func(t *testing.T) {
+		upgradeKeeper, ctx := setupPendingUpgrade(t)
+		signer := testutil.ValAddrs[0].Bytes()
+
+		// signalling for another version is still rejected while an upgrade is pending
+		_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 6})
+		require.ErrorIs(t, err, types.ErrUpgradePending)
+
+		// validators 0 and 2 only hold 99 out of the 100 voting power required
+		for _, valAddr := range []sdk.ValAddress{testutil.ValAddrs[0], testutil.ValAddrs[2]} {
+			_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: v4.Version})
+			require.NoError(t, err)
+		}
+		_, err = upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(signer))
+		require.ErrorIs(t, err, types.ErrCancelQuorumNotReached)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+
+		_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[1].String(), Version: v4.Version})
+		require.NoError(t, err)
+		_, err = upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(signer))
+		require.NoError(t, err)
+		require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+
+		// validators can signal for a new version once the upgrade is cancelled
+		_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 6})
+		require.NoError(t, err)
+	})
+}
+
+func TestSignalExpiry(t *testing.T) {
+	t.Run("signals expire from v4", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		ctx = withAppVersion(ctx, v4.Version).WithBlockHeight(1)
+		for _, valAddr := range testutil.ValAddrs[:4] {
+			_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: 5})
+			require.NoError(t, err)
+		}
+
+		expiry := types.DefaultSignalExpiry
+		ctx = ctx.WithBlockHeight(1 + expiry - 1)
+		res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 5})
+		require.NoError(t, err)
+		require.EqualValues(t, 120, res.VotingPower)
+
+		ctx = ctx.WithBlockHeight(1 + expiry)
+		res, err = upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 5})
+		require.NoError(t, err)
+		require.EqualValues(t, 0, res.VotingPower)
+
+		_, err = upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+
+		// re-signalling refreshes the signal
+		_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[2].String(), Version: 5})
+		require.NoError(t, err)
+		res, err = upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 5})
+		require.NoError(t, err)
+		require.EqualValues(t, 59, res.VotingPower)
+	})
+
+	t.Run("signals expire after the signal expiry param", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		ctx = withAppVersion(ctx, v4.Version).WithBlockHeight(1)
+		upgradeKeeper.SetParams(ctx, types.NewParams(10))
+		require.EqualValues(t, 10, upgradeKeeper.SignalExpiry(ctx))
+		_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 5})
+		require.NoError(t, err)
+
+		res, err := upgradeKeeper.VersionTally(ctx.WithBlockHeight(10), &types.QueryVersionTallyRequest{Version: 5})
+		require.NoError(t, err)
+		require.EqualValues(t, 40, res.VotingPower)
+
+		res, err = upgradeKeeper.VersionTally(ctx.WithBlockHeight(11), &types.QueryVersionTallyRequest{Version: 5})
+		require.NoError(t, err)
+		require.EqualValues(t, 0, res.VotingPower)
+	})
+
+	t.Run("signals don't expire before v4", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		ctx = withAppVersion(ctx, v3.Version).WithBlockHeight(1)
+		_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 4})
+		require.NoError(t, err)
+
+		ctx = ctx.WithBlockHeight(1 + types.DefaultSignalExpiry)
+		res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 4})
+		require.NoError(t, err)
+		require.EqualValues(t, 40, res.VotingPower)
+	})
+}
+
+func TestValidatorSignals(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	ctx = withAppVersion(ctx, v4.Version).WithBlockHeight(1)
+	signalled := map[string]uint64{
+		testutil.ValAddrs[0].String(): 5,
+		testutil.ValAddrs[2].String(): 5,
+		testutil.ValAddrs[3].String(): 6,
+	}
+	for valAddr, version := range signalled {
+		_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr, Version: version})
+		require.NoError(t, err)
+	}
+
+	got, err := upgradeKeeper.ValidatorSignals(ctx, &types.QueryValidatorSignalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, got.Signals, 4)
+	require.EqualValues(t, 4, got.Pagination.Total)
+	require.Nil(t, got.Pagination.NextKey)
+	powers := map[string]uint64{}
+	for i, signal := range got.Signals {
+		// the validators are ordered by operator address
+		if i > 0 {
+			prev, err := sdk.ValAddressFromBech32(got.Signals[i-1].OperatorAddress)
+			require.NoError(t, err)
+			cur, err := sdk.ValAddressFromBech32(signal.OperatorAddress)
+			require.NoError(t, err)
+			require.Negative(t, bytes.Compare(prev, cur))
+		}
+		assert.Equal(t, "moniker-"+signal.OperatorAddress, signal.Moniker)
+		assert.Equal(t, signalled[signal.OperatorAddress], signal.Version)
+		powers[signal.OperatorAddress] = signal.VotingPower
+	}
+	assert.EqualValues(t, 1, powers[testutil.ValAddrs[1].String()])
+	assert.EqualValues(t, 59, powers[testutil.ValAddrs[2].String()])
+
+	t.Run("paginates by key and offset", func(t *testing.T) {
+		first, err := upgradeKeeper.ValidatorSignals(ctx, &types.QueryValidatorSignalsRequest{Pagination: &query.PageRequest{Limit: 3}})
+		require.NoError(t, err)
+		require.Equal(t, got.Signals[:3], first.Signals)
+		require.NotNil(t, first.Pagination.NextKey)
+
+		second, err := upgradeKeeper.ValidatorSignals(ctx, &types.QueryValidatorSignalsRequest{Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Limit: 3}})
+		require.NoError(t, err)
+		require.Equal(t, got.Signals[3:], second.Signals)
+		require.Nil(t, second.Pagination.NextKey)
+
+		byOffset, err := upgradeKeeper.ValidatorSignals(ctx, &types.QueryValidatorSignalsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true}})
+		require.NoError(t, err)
+		require.Equal(t, got.Signals[1:3], byOffset.Signals)
+		require.EqualValues(t, 4, byOffset.Pagination.Total)
+
+		_, err = upgradeKeeper.ValidatorSignals(ctx, &types.QueryValidatorSignalsRequest{Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Offset: 1}})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+
+	t.Run("expired signals are reported as no signal", func(t *testing.T) {
+		expiredCtx := ctx.WithBlockHeight(1 + types.DefaultSignalExpiry)
+		got, err := upgradeKeeper.ValidatorSignals(expiredCtx, &types.QueryValidatorSignalsRequest{})
+		require.NoError(t, err)
+		for _, signal := range got.Signals {
+			assert.Zero(t, signal.Version)
+		}
+	})
+}
+
+func TestVersionTallies(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+
+	got, err := upgradeKeeper.VersionTallies(ctx, &types.QueryVersionTalliesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, got.Tallies)
+
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 3})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[1].String(), Version: 2})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[2].String(), Version: 3})
+	require.NoError(t, err)
+
+	got, err = upgradeKeeper.VersionTallies(ctx, &types.QueryVersionTalliesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.VersionTally{
+		{Version: 2, VotingPower: 1},
+		{Version: 3, VotingPower: 99},
+	}, got.Tallies)
+	require.EqualValues(t, 100, got.ThresholdPower)
+	require.EqualValues(t, 120, got.TotalVotingPower)
+}
+
+func TestSupportedVersions(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+
+	got, err := upgradeKeeper.SupportedVersions(ctx, &types.QuerySupportedVersionsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, got.Versions)
+
+	upgradeKeeper.SetSupportedVersions(func() []uint64 { return []uint64{1, 2, 3} })
+	got, err = upgradeKeeper.SupportedVersions(ctx, &types.QuerySupportedVersionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, got.Versions)
+}
+
+func withAppVersion(ctx sdk.Context, appVersion uint64) sdk.Context {
+	header := ctx.BlockHeader()
+	header.Version.App = appVersion
+	return ctx.WithBlockHeader(header)
+}
+
+// authority is the address of the governance module account.
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 	signalStore := sdk.NewKVStoreKey(types.StoreKey)
+	paramsStore := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTStore := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(signalStore, storetypes.StoreTypeIAVL, nil)
+	stateStore.MountStoreWithDB(paramsStore, storetypes.StoreTypeIAVL, nil)
+	stateStore.MountStoreWithDB(paramsTStore, storetypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 	mockCtx := sdk.NewContext(stateStore, tmproto.Header{
 		Version: tmversion.Consensus{
@@ -489,7 +750,8 @@ func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 	)
 
 	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper)
+	subspace := paramtypes.NewSubspace(config.Codec, config.Amino, paramsStore, paramsTStore, types.ModuleName)
+	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, subspace, mockStakingKeeper, authority)
 	return upgradeKeeper, mockCtx, mockStakingKeeper
 }
 
@@ -530,3 +792,14 @@ func (m *mockStakingKeeper) GetValidator(_ sdk.Context, addr sdk.ValAddress) (va
 	}
 	return stakingtypes.Validator{}, false
 }
+
+func (m *mockStakingKeeper) GetLastValidators(_ sdk.Context) (validators []stakingtypes.Validator) {
+	for addrStr := range m.validators {
+		validators = append(validators, stakingtypes.Validator{
+			OperatorAddress: addrStr,
+			Description:     stakingtypes.Description{Moniker: "moniker-" + addrStr},
+			Status:          stakingtypes.Bonded,
+		})
+	}
+	return validators
+}
@@ -23,7 +23,10 @@ func init() {
 
 const (
 	// consensusVersion defines the current x/signal module consensus version.
-	consensusVersion uint64 = 3
+	consensusVersion uint64 = 4
+	// consensusVersionV3 is the consensus version of the x/signal module
+	// before upgrades could be cancelled.
+	consensusVersionV3 uint64 = 3
 )
 
 var (
@@ -79,14 +82,27 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 // AppModule implements the sdk.AppModule interface
 type AppModule struct {
 	AppModuleBasic
-	keeper Keeper
+	keeper           Keeper
+	consensusVersion uint64
 }
 
-// NewAppModule creates a new AppModule object
+// NewAppModule creates a new AppModule object that accepts MsgCancelUpgrade.
+// It is used from app version 4.
 func NewAppModule(keeper Keeper) AppModule {
 	return AppModule{
-		AppModuleBasic: AppModuleBasic{},
-		keeper:         keeper,
+		AppModuleBasic:   AppModuleBasic{},
+		keeper:           keeper,
+		consensusVersion: consensusVersion,
+	}
+}
+
+// NewAppModuleV3 creates a new AppModule object that doesn't accept
+// MsgCancelUpgrade. It is used by app versions 2 and 3.
+func NewAppModuleV3(keeper Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic:   AppModuleBasic{},
+		keeper:           keeper,
+		consensusVersion: consensusVersionV3,
 	}
 }
 
@@ -110,12 +126,31 @@ func (AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	if am.consensusVersion == consensusVersionV3 {
+		types.RegisterMsgServerV3(cfg.MsgServer(), &am.keeper)
+		types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+		return
+	}
+
+	// The query server is registered by the module of consensus version 3.
 	types.RegisterMsgServer(cfg.MsgServer(), &am.keeper)
-	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	// The signals are reset by the upgrade so only the params, which are added
+	// in consensus version 4, are migrated.
+	if err := cfg.RegisterMigration(types.ModuleName, consensusVersionV3, func(ctx sdk.Context) error {
+		am.keeper.SetParams(ctx, types.DefaultParams())
+		return nil
+	}); err != nil {
+		panic(err)
+	}
 }
 
-// InitGenesis does nothing because there is no sense in serializing future upgrades.
-func (AppModule) InitGenesis(_ sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+// InitGenesis sets the default params from consensus version 4. The signals
+// aren't part of the genesis because there is no sense in serializing future
+// upgrades.
+func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+	if am.consensusVersion != consensusVersionV3 {
+		am.keeper.SetParams(ctx, types.DefaultParams())
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -125,4 +160,4 @@ func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMe
 }
 
 // ConsensusVersion returns the consensus version of this module.
-func (AppModule) ConsensusVersion() uint64 { return consensusVersion }
+func (am AppModule) ConsensusVersion() uint64 { return am.consensusVersion }
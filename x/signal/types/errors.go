@@ -5,7 +5,10 @@ import (
 )
 
 var (
-	ErrInvalidSignalVersion  = errors.Register(ModuleName, 1, "invalid signal version because signal version can not be less than the current version")
-	ErrInvalidUpgradeVersion = errors.Register(ModuleName, 3, "invalid upgrade version")
-	ErrUpgradePending        = errors.Register(ModuleName, 2, "upgrade is already pending")
+	ErrInvalidSignalVersion   = errors.Register(ModuleName, 1, "invalid signal version because signal version can not be less than the current version")
+	ErrInvalidUpgradeVersion  = errors.Register(ModuleName, 3, "invalid upgrade version")
+	ErrUpgradePending         = errors.Register(ModuleName, 2, "upgrade is already pending")
+	ErrNoUpgradePending       = errors.Register(ModuleName, 4, "no upgrade is pending")
+	ErrCancelQuorumNotReached = errors.Register(ModuleName, 5, "quorum of voting power signalling for the current version not reached")
+	ErrUnsupportedVersion     = errors.Register(ModuleName, 6, "signal version is not supported by this binary")
 )
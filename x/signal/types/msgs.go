@@ -16,13 +16,16 @@ const (
 
 	URLMsgSignalVersion = "/celestia.signal.v1.Msg/SignalVersion"
 	URLMsgTryUpgrade    = "/celestia.signal.v1.Msg/TryUpgrade"
+	URLMsgCancelUpgrade = "/celestia.signal.v1.Msg/CancelUpgrade"
 )
 
 var (
 	_ sdk.Msg            = &MsgSignalVersion{}
 	_ sdk.Msg            = &MsgTryUpgrade{}
+	_ sdk.Msg            = &MsgCancelUpgrade{}
 	_ legacytx.LegacyMsg = &MsgSignalVersion{}
 	_ legacytx.LegacyMsg = &MsgTryUpgrade{}
+	_ legacytx.LegacyMsg = &MsgCancelUpgrade{}
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -95,3 +98,37 @@ func (msg *MsgTryUpgrade) Route() string {
 func (msg *MsgTryUpgrade) Type() string {
 	return URLMsgTryUpgrade
 }
+
+func NewMsgCancelUpgrade(signer sdk.AccAddress) *MsgCancelUpgrade {
+	return &MsgCancelUpgrade{
+		Signer: signer.String(),
+	}
+}
+
+func (msg *MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgCancelUpgrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	return err
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+func (msg *MsgCancelUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements legacytx.LegacyMsg.
+func (msg *MsgCancelUpgrade) Route() string {
+	return RouterKey
+}
+
+// Type implements legacytx.LegacyMsg.
+func (msg *MsgCancelUpgrade) Type() string {
+	return URLMsgCancelUpgrade
+}
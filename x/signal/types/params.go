@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	v4 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v4"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeySignalExpiry = []byte("SignalExpiry")
+	// DefaultSignalExpiry is approximately 30 days.
+	DefaultSignalExpiry = v4.SignalExpiry
+)
+
+// Params are the params of the signal module. They are used from app version
+// 4.
+type Params struct {
+	// SignalExpiry is the number of blocks after which the signal of a
+	// validator expires.
+	SignalExpiry int64
+}
+
+// ParamKeyTable returns the param key table for the signal module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(signalExpiry int64) Params {
+	return Params{
+		SignalExpiry: signalExpiry,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultSignalExpiry)
+}
+
+// ParamSetPairs gets the list of param key-value pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySignalExpiry, &p.SignalExpiry, validateSignalExpiry),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	return validateSignalExpiry(p.SignalExpiry)
+}
+
+// validateSignalExpiry validates the SignalExpiry param.
+func validateSignalExpiry(v interface{}) error {
+	expiry, ok := v.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if expiry <= 0 {
+		return fmt.Errorf("signal expiry must be positive, got %d", expiry)
+	}
+
+	return nil
+}
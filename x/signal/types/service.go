@@ -0,0 +1,20 @@
+package types
+
+import (
+	grpc1 "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+)
+
+// RegisterMsgServerV3 registers the methods of the Msg service that are
+// supported by the consensus version 3 of the module, i.e. all of them but
+// CancelUpgrade.
+func RegisterMsgServerV3(s grpc1.Server, srv MsgServer) {
+	desc := _Msg_serviceDesc
+	desc.Methods = make([]grpc.MethodDesc, 0, len(_Msg_serviceDesc.Methods))
+	for _, method := range _Msg_serviceDesc.Methods {
+		if method.MethodName != "CancelUpgrade" {
+			desc.Methods = append(desc.Methods, method)
+		}
+	}
+	s.RegisterService(&desc, srv)
+}
@@ -200,39 +200,128 @@ func (m *MsgTryUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTryUpgradeResponse proto.InternalMessageInfo
 
+// MsgCancelUpgrade cancels the pending upgrade.
+type MsgCancelUpgrade struct {
+	// signer is the bech32 encoded address of the account cancelling the
+	// upgrade. If it's the address of the governance module account, the upgrade
+	// is cancelled regardless of the signals of the validators.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgCancelUpgrade) Reset()         { *m = MsgCancelUpgrade{} }
+func (m *MsgCancelUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgrade) ProtoMessage()    {}
+func (*MsgCancelUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{4}
+}
+func (m *MsgCancelUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgrade.Merge(m, src)
+}
+func (m *MsgCancelUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgrade proto.InternalMessageInfo
+
+func (m *MsgCancelUpgrade) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgCancelUpgradeResponse is the response type for the CancelUpgrade method.
+type MsgCancelUpgradeResponse struct {
+}
+
+func (m *MsgCancelUpgradeResponse) Reset()         { *m = MsgCancelUpgradeResponse{} }
+func (m *MsgCancelUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgradeResponse) ProtoMessage()    {}
+func (*MsgCancelUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{5}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgradeResponse.Merge(m, src)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSignalVersion)(nil), "celestia.signal.v1.MsgSignalVersion")
 	proto.RegisterType((*MsgSignalVersionResponse)(nil), "celestia.signal.v1.MsgSignalVersionResponse")
 	proto.RegisterType((*MsgTryUpgrade)(nil), "celestia.signal.v1.MsgTryUpgrade")
 	proto.RegisterType((*MsgTryUpgradeResponse)(nil), "celestia.signal.v1.MsgTryUpgradeResponse")
+	proto.RegisterType((*MsgCancelUpgrade)(nil), "celestia.signal.v1.MsgCancelUpgrade")
+	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "celestia.signal.v1.MsgCancelUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/tx.proto", fileDescriptor_815f2cc162e6e27e) }
 
 var fileDescriptor_815f2cc162e6e27e = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x4a, 0x3b, 0x31,
-	0x14, 0xc5, 0x9b, 0xfe, 0xff, 0x54, 0x0c, 0x14, 0xda, 0xf8, 0x35, 0x8e, 0x32, 0xd4, 0x41, 0xb0,
-	0xa2, 0x4e, 0x68, 0x7d, 0x02, 0x5d, 0xdb, 0x4d, 0xfd, 0x00, 0xdd, 0x48, 0xda, 0x09, 0x31, 0x30,
-	0x4e, 0x42, 0x92, 0x0e, 0xed, 0xc6, 0x85, 0x4f, 0x20, 0xf8, 0x52, 0x2e, 0x0b, 0x6e, 0x5c, 0x4a,
-	0xeb, 0x6b, 0x08, 0x62, 0xd3, 0x19, 0xa7, 0x15, 0xd1, 0xdd, 0xbd, 0x73, 0x7f, 0xf7, 0x9c, 0x33,
-	0x97, 0xc0, 0x8d, 0x2e, 0x8d, 0xa8, 0x36, 0x9c, 0x60, 0xcd, 0x59, 0x4c, 0x22, 0x9c, 0x34, 0xb0,
-	0xe9, 0x07, 0x52, 0x09, 0x23, 0x10, 0x4a, 0x87, 0x81, 0x1d, 0x06, 0x49, 0xc3, 0xdd, 0x64, 0x42,
-	0xb0, 0x88, 0x62, 0x22, 0x39, 0x26, 0x71, 0x2c, 0x0c, 0x31, 0x5c, 0xc4, 0xda, 0x6e, 0xf8, 0x97,
-	0xb0, 0xd2, 0xd2, 0xec, 0x74, 0x42, 0x5f, 0x50, 0xa5, 0xb9, 0x88, 0xd1, 0x1e, 0xac, 0x26, 0x24,
-	0xe2, 0x21, 0x31, 0x42, 0x5d, 0x93, 0x30, 0x54, 0x54, 0x6b, 0x07, 0xd4, 0x40, 0x7d, 0xb1, 0x5d,
-	0xc9, 0x06, 0x47, 0xf6, 0x3b, 0x72, 0xe0, 0x42, 0x62, 0xf7, 0x9c, 0x62, 0x0d, 0xd4, 0xff, 0xb7,
-	0xd3, 0xd6, 0x77, 0xa1, 0x33, 0x2f, 0xdd, 0xa6, 0x5a, 0x8a, 0x58, 0x53, 0x7f, 0x07, 0x96, 0x5b,
-	0x9a, 0x9d, 0xa9, 0xc1, 0xb9, 0x64, 0x8a, 0x84, 0x14, 0xad, 0xc2, 0xd2, 0x67, 0x64, 0xaa, 0xa6,
-	0x46, 0xd3, 0xce, 0x5f, 0x83, 0x2b, 0x33, 0x60, 0xaa, 0xd0, 0x7c, 0x07, 0xf0, 0x5f, 0x4b, 0x33,
-	0x74, 0x07, 0xcb, 0xb3, 0xe9, 0xb7, 0x83, 0xef, 0x47, 0x08, 0xe6, 0x83, 0xb8, 0xfb, 0x7f, 0xa1,
-	0xb2, 0xb8, 0xeb, 0xf7, 0xcf, 0x6f, 0x8f, 0xc5, 0x25, 0xbf, 0x9a, 0x3b, 0xba, 0xad, 0x50, 0x02,
-	0x61, 0xee, 0x37, 0xb6, 0x7e, 0x90, 0xfd, 0x42, 0xdc, 0xdd, 0x5f, 0x91, 0xcc, 0xd6, 0x9d, 0xd8,
-	0x2e, 0xfb, 0x28, 0x67, 0xdb, 0xb3, 0xcc, 0xf1, 0xc9, 0xd3, 0xc8, 0x03, 0xc3, 0x91, 0x07, 0x5e,
-	0x47, 0x1e, 0x78, 0x18, 0x7b, 0x85, 0xe1, 0xd8, 0x2b, 0xbc, 0x8c, 0xbd, 0xc2, 0x55, 0x93, 0x71,
-	0x73, 0xd3, 0xeb, 0x04, 0x5d, 0x71, 0x8b, 0x53, 0x2b, 0xa1, 0x58, 0x56, 0x1f, 0x10, 0x29, 0x71,
-	0x3f, 0x95, 0x34, 0x03, 0x49, 0x75, 0xa7, 0x34, 0x79, 0x0d, 0x87, 0x1f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xfd, 0x76, 0xc1, 0x4b, 0x5e, 0x02, 0x00, 0x00,
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x4a, 0xfb, 0x40,
+	0x14, 0xc5, 0x9b, 0xfe, 0xff, 0x54, 0x1c, 0x28, 0xb4, 0xe3, 0x57, 0x8c, 0x12, 0x6a, 0x10, 0xac,
+	0x5a, 0x13, 0x5a, 0x9f, 0x40, 0xdd, 0xda, 0x4d, 0xfd, 0x00, 0xdd, 0xc8, 0x34, 0x19, 0xc6, 0x40,
+	0x9c, 0x19, 0x66, 0xa6, 0xa1, 0xdd, 0x74, 0xe1, 0x13, 0x08, 0xbe, 0x94, 0xcb, 0x82, 0x1b, 0x97,
+	0xd2, 0xfa, 0x1a, 0x82, 0x98, 0x34, 0xb1, 0xa9, 0xd4, 0x76, 0x37, 0x93, 0xfb, 0xbb, 0xe7, 0xdc,
+	0x7b, 0x32, 0x60, 0xcb, 0xc5, 0x01, 0x96, 0xca, 0x47, 0x8e, 0xf4, 0x09, 0x45, 0x81, 0x13, 0xd6,
+	0x1d, 0xd5, 0xb5, 0xb9, 0x60, 0x8a, 0x41, 0x98, 0x14, 0xed, 0xb8, 0x68, 0x87, 0x75, 0x63, 0x9b,
+	0x30, 0x46, 0x02, 0xec, 0x20, 0xee, 0x3b, 0x88, 0x52, 0xa6, 0x90, 0xf2, 0x19, 0x95, 0x71, 0x87,
+	0x75, 0x03, 0x4a, 0x4d, 0x49, 0x2e, 0x22, 0xfa, 0x1a, 0x0b, 0xe9, 0x33, 0x0a, 0x0f, 0x41, 0x39,
+	0x44, 0x81, 0xef, 0x21, 0xc5, 0xc4, 0x1d, 0xf2, 0x3c, 0x81, 0xa5, 0xd4, 0xb5, 0x8a, 0x56, 0x5d,
+	0x6e, 0x95, 0xd2, 0xc2, 0x49, 0xfc, 0x1d, 0xea, 0x60, 0x29, 0x8c, 0xfb, 0xf4, 0x7c, 0x45, 0xab,
+	0xfe, 0x6f, 0x25, 0x57, 0xcb, 0x00, 0xfa, 0xb4, 0x74, 0x0b, 0x4b, 0xce, 0xa8, 0xc4, 0xd6, 0x1e,
+	0x28, 0x36, 0x25, 0xb9, 0x14, 0xbd, 0x2b, 0x4e, 0x04, 0xf2, 0x30, 0x5c, 0x07, 0x85, 0xef, 0x91,
+	0xb1, 0x18, 0x1b, 0x8d, 0x6f, 0xd6, 0x06, 0x58, 0xcb, 0x80, 0xa9, 0xc2, 0x41, 0x34, 0xf8, 0x19,
+	0xa2, 0x2e, 0x0e, 0xe6, 0x89, 0xc4, 0x93, 0x64, 0xd8, 0x44, 0xa7, 0xf1, 0x99, 0x07, 0xff, 0x9a,
+	0x92, 0xc0, 0x3e, 0x28, 0x66, 0x53, 0xd8, 0xb5, 0x7f, 0x87, 0x69, 0x4f, 0x2f, 0x64, 0xd4, 0x16,
+	0xa1, 0xd2, 0xa1, 0x37, 0x1f, 0x5f, 0x3f, 0x9e, 0xf3, 0x2b, 0x56, 0x79, 0xe2, 0xe7, 0xc5, 0x27,
+	0x18, 0x02, 0x30, 0x11, 0xc7, 0xce, 0x0c, 0xd9, 0x1f, 0xc4, 0xd8, 0x9f, 0x8b, 0xa4, 0xb6, 0x46,
+	0x64, 0xbb, 0x6a, 0xc1, 0x09, 0xdb, 0xce, 0xd8, 0xa9, 0x0f, 0x8a, 0xd9, 0x10, 0x67, 0xed, 0x9d,
+	0xa1, 0x8c, 0xda, 0x22, 0xd4, 0x9f, 0x7b, 0xbb, 0x11, 0x79, 0x7a, 0xfe, 0x32, 0x34, 0xb5, 0xc1,
+	0xd0, 0xd4, 0xde, 0x87, 0xa6, 0xf6, 0x34, 0x32, 0x73, 0x83, 0x91, 0x99, 0x7b, 0x1b, 0x99, 0xb9,
+	0xdb, 0x06, 0xf1, 0xd5, 0x7d, 0xa7, 0x6d, 0xbb, 0xec, 0xc1, 0x49, 0xcc, 0x98, 0x20, 0xe9, 0xf9,
+	0x08, 0x71, 0xee, 0x74, 0x13, 0x45, 0xd5, 0xe3, 0x58, 0xb6, 0x0b, 0xd1, 0xab, 0x3e, 0xfe, 0x1a,
+	0x00, 0x6d, 0x20, 0x66, 0x01, 0x26, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -252,6 +341,10 @@ type MsgClient interface {
 	// TryUpgrade tallies all the votes for all the versions to determine if a
 	// quorum has been reached for a version.
 	TryUpgrade(ctx context.Context, in *MsgTryUpgrade, opts ...grpc.CallOption) (*MsgTryUpgradeResponse, error)
+	// CancelUpgrade cancels the pending upgrade if a quorum of validators has
+	// signalled for the current version or if the signer is the governance
+	// module account.
+	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -280,6 +373,15 @@ func (c *msgClient) TryUpgrade(ctx context.Context, in *MsgTryUpgrade, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error) {
+	out := new(MsgCancelUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Msg/CancelUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SignalVersion allows a validator to signal for a version.
@@ -287,6 +389,10 @@ type MsgServer interface {
 	// TryUpgrade tallies all the votes for all the versions to determine if a
 	// quorum has been reached for a version.
 	TryUpgrade(context.Context, *MsgTryUpgrade) (*MsgTryUpgradeResponse, error)
+	// CancelUpgrade cancels the pending upgrade if a quorum of validators has
+	// signalled for the current version or if the signer is the governance
+	// module account.
+	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -299,6 +405,9 @@ func (*UnimplementedMsgServer) SignalVersion(ctx context.Context, req *MsgSignal
 func (*UnimplementedMsgServer) TryUpgrade(ctx context.Context, req *MsgTryUpgrade) (*MsgTryUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TryUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -340,6 +449,24 @@ func _Msg_TryUpgrade_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Msg/CancelUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUpgrade(ctx, req.(*MsgCancelUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -352,6 +479,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TryUpgrade",
 			Handler:    _Msg_TryUpgrade_Handler,
 		},
+		{
+			MethodName: "CancelUpgrade",
+			Handler:    _Msg_CancelUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/tx.proto",
@@ -468,6 +599,59 @@ func (m *MsgTryUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -526,6 +710,28 @@ func (m *MsgTryUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -815,6 +1021,138 @@ func (m *MsgTryUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0